@@ -1,6 +1,7 @@
 package alphavintage
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"sort"
@@ -17,8 +18,10 @@ const openRouterURL = "https://openrouter.ai/api/v1/chat/completions"
 type AIClient struct {
 	apiKey    string
 	model     string
+	url       string
 	resty     *resty.Client
 	reasoning bool
+	retry     *RetryPolicy
 }
 
 // AIConfig configures the AI client
@@ -36,15 +39,18 @@ func DefaultAIConfig() AIConfig {
 	}
 }
 
-// NewAIClient creates a new AI client for OpenRouter
-func NewAIClient(config AIConfig) *AIClient {
+// NewAIClient creates a new AI client for OpenRouter.
+// WithBaseURL takes the full chat completions URL
+func NewAIClient(config AIConfig, opts ...Option) *AIClient {
 	if config.Model == "" {
 		config.Model = "nvidia/nemotron-3-nano-30b-a3b:free"
 	}
+	cfg := newHTTPConfig(openRouterURL, opts)
 	return &AIClient{
 		apiKey:    config.APIKey,
 		model:     config.Model,
-		resty:     resty.New().SetTimeout(60 * time.Second),
+		url:       cfg.baseURL,
+		resty:     cfg.newResty(60 * time.Second),
 		reasoning: config.Reasoning,
 	}
 }
@@ -61,6 +67,12 @@ func (ai *AIClient) SetReasoning(enabled bool) *AIClient {
 	return ai
 }
 
+// WithRetry retries rate-limited and transient failures according to policy
+func (ai *AIClient) WithRetry(policy RetryPolicy) *AIClient {
+	ai.retry = &policy
+	return ai
+}
+
 type openRouterRequest struct {
 	Model     string          `json:"model"`
 	Messages  []aiMessage     `json:"messages"`
@@ -84,10 +96,11 @@ type openRouterResponse struct {
 	} `json:"choices"`
 	Error *struct {
 		Message string `json:"message"`
+		Code    int    `json:"code"`
 	} `json:"error,omitempty"`
 }
 
-func (ai *AIClient) chat(prompt string) (string, error) {
+func (ai *AIClient) chat(ctx context.Context, prompt string) (string, error) {
 	req := openRouterRequest{
 		Model: ai.model,
 		Messages: []aiMessage{
@@ -99,23 +112,58 @@ func (ai *AIClient) chat(prompt string) (string, error) {
 		req.Reasoning = &reasoningOpts{Enabled: true}
 	}
 
+	content, err := ai.retry.do(ctx, func() ([]byte, error) {
+		content, err := ai.send(ctx, req)
+		return []byte(content), err
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+func (ai *AIClient) send(ctx context.Context, req openRouterRequest) (string, error) {
 	resp, err := ai.resty.R().
+		SetContext(ctx).
 		SetHeader("Content-Type", "application/json").
 		SetHeader("Authorization", "Bearer "+ai.apiKey).
 		SetBody(req).
-		Post(openRouterURL)
+		Post(ai.url)
 
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return "", ctxErr
+		}
 		return "", fmt.Errorf("request failed: %w", err)
 	}
 
 	var result openRouterResponse
 	if err := json.Unmarshal(resp.Body(), &result); err != nil {
-		return "", fmt.Errorf("parse error: %w", err)
+		if resp.StatusCode() != 200 {
+			return "", &HTTPError{Provider: ProviderOpenRouter, Function: ai.model, StatusCode: resp.StatusCode()}
+		}
+		return "", &DecodeError{Provider: ProviderOpenRouter, Function: ai.model, Err: err}
 	}
 
 	if result.Error != nil {
-		return "", fmt.Errorf("API error: %s", result.Error.Message)
+		code := result.Error.Code
+		if code == 0 {
+			code = resp.StatusCode()
+		}
+		kind := statusKind(code, "")
+		if kind == nil {
+			kind = ErrAPI
+		}
+		return "", &APIError{
+			Kind:     kind,
+			Provider: ProviderOpenRouter,
+			Function: ai.model,
+			Message:  result.Error.Message,
+		}
+	}
+
+	if resp.StatusCode() != 200 {
+		return "", &HTTPError{Provider: ProviderOpenRouter, Function: ai.model, StatusCode: resp.StatusCode()}
 	}
 
 	if len(result.Choices) == 0 {
@@ -126,14 +174,31 @@ func (ai *AIClient) chat(prompt string) (string, error) {
 }
 
 
-// StockAnalysisData holds all data for AI analysis
+// StockAnalysisData holds all data for AI analysis. When Splits or Dividends
+// are set, Daily is back-adjusted before it reaches the prompts, so Daily must
+// not be adjusted already
 type StockAnalysisData struct {
 	Symbol       string
-	Daily        *TimeSeriesDailyResponse
+	Daily        *TimeSeriesDailyResponse // Unadjusted, e.g. from GetTimeSeriesDaily
 	Earnings     *EarningsResponse
 	CashFlow     *CashFlowResponse
 	BalanceSheet *BalanceSheetResponse
 	News         *NewsSentimentResponse
+	Overview     *CompanyOverview
+	Income       *IncomeStatementResponse
+	Dividends    *DividendsResponse // With Splits, used to back-adjust Daily
+	Splits       *SplitsResponse
+	Transcript   *EarningsCallTranscriptResponse // For AnalyzeEarningsCall
+}
+
+// prices returns Daily, back-adjusted when splits or dividends are known.
+// Daily must be unadjusted: a series from TimeSeriesAdjustedResponse.ToDaily(true)
+// combined with Splits or Dividends would be adjusted twice
+func (data StockAnalysisData) prices() *TimeSeriesDailyResponse {
+	if data.Splits == nil && data.Dividends == nil {
+		return data.Daily
+	}
+	return AdjustDaily(data.Daily, data.Splits, data.Dividends)
 }
 
 // AnalysisSummary contains AI-generated summaries
@@ -147,40 +212,54 @@ type AnalysisSummary struct {
 
 // GenerateFullAnalysis generates comprehensive AI analysis
 func (ai *AIClient) GenerateFullAnalysis(data StockAnalysisData) (*AnalysisSummary, error) {
+	return ai.GenerateFullAnalysisCtx(context.Background(), data)
+}
+
+// GenerateFullAnalysisCtx is like GenerateFullAnalysis but aborts when ctx is done
+func (ai *AIClient) GenerateFullAnalysisCtx(ctx context.Context, data StockAnalysisData) (*AnalysisSummary, error) {
 	summary := &AnalysisSummary{}
 	var err error
 
 	// Generate each section
-	summary.Executive, err = ai.GenerateExecutiveSummary(data)
+	summary.Executive, err = ai.GenerateExecutiveSummaryCtx(ctx, data)
 	if err != nil {
 		summary.Executive = "Unable to generate executive summary."
 	}
 
-	summary.PriceAnalysis, err = ai.AnalyzePriceTrend(data.Daily)
+	summary.PriceAnalysis, err = ai.AnalyzePriceTrendCtx(ctx, data.prices())
 	if err != nil {
 		summary.PriceAnalysis = "Unable to analyze price trends."
 	}
 
-	summary.Fundamentals, err = ai.AnalyzeFundamentals(data)
+	summary.Fundamentals, err = ai.AnalyzeFundamentalsCtx(ctx, data)
 	if err != nil {
 		summary.Fundamentals = "Unable to analyze fundamentals."
 	}
 
-	summary.Risks, err = ai.AssessRisks(data)
+	summary.Risks, err = ai.AssessRisksCtx(ctx, data)
 	if err != nil {
 		summary.Risks = "Unable to assess risks."
 	}
 
-	summary.Outlook, err = ai.GenerateOutlook(data)
+	summary.Outlook, err = ai.GenerateOutlookCtx(ctx, data)
 	if err != nil {
 		summary.Outlook = "Unable to generate outlook."
 	}
 
+	// Sections fail soft, but a cancelled ctx is the caller's to see
+	if err := ctx.Err(); err != nil {
+		return summary, err
+	}
 	return summary, nil
 }
 
 // GenerateExecutiveSummary creates a brief executive summary
 func (ai *AIClient) GenerateExecutiveSummary(data StockAnalysisData) (string, error) {
+	return ai.GenerateExecutiveSummaryCtx(context.Background(), data)
+}
+
+// GenerateExecutiveSummaryCtx is like GenerateExecutiveSummary but aborts when ctx is done
+func (ai *AIClient) GenerateExecutiveSummaryCtx(ctx context.Context, data StockAnalysisData) (string, error) {
 	prompt := fmt.Sprintf(`Analyze this stock data for %s and provide a brief executive summary (3-4 sentences).
 
 %s
@@ -188,11 +267,16 @@ func (ai *AIClient) GenerateExecutiveSummary(data StockAnalysisData) (string, er
 Provide a concise, professional summary focusing on key metrics and overall health.`, 
 		data.Symbol, formatDataForAI(data))
 
-	return ai.chat(prompt)
+	return ai.chat(ctx, prompt)
 }
 
 // AnalyzePriceTrend analyzes price movements
 func (ai *AIClient) AnalyzePriceTrend(data *TimeSeriesDailyResponse) (string, error) {
+	return ai.AnalyzePriceTrendCtx(context.Background(), data)
+}
+
+// AnalyzePriceTrendCtx is like AnalyzePriceTrend but aborts when ctx is done
+func (ai *AIClient) AnalyzePriceTrendCtx(ctx context.Context, data *TimeSeriesDailyResponse) (string, error) {
 	if data == nil || len(data.TimeSeries) == 0 {
 		return "", fmt.Errorf("no price data")
 	}
@@ -204,11 +288,16 @@ func (ai *AIClient) AnalyzePriceTrend(data *TimeSeriesDailyResponse) (string, er
 
 Focus on: trend direction, volatility, support/resistance levels, and notable patterns.`, priceData)
 
-	return ai.chat(prompt)
+	return ai.chat(ctx, prompt)
 }
 
 // AnalyzeFundamentals analyzes earnings, cash flow, balance sheet
 func (ai *AIClient) AnalyzeFundamentals(data StockAnalysisData) (string, error) {
+	return ai.AnalyzeFundamentalsCtx(context.Background(), data)
+}
+
+// AnalyzeFundamentalsCtx is like AnalyzeFundamentals but aborts when ctx is done
+func (ai *AIClient) AnalyzeFundamentalsCtx(ctx context.Context, data StockAnalysisData) (string, error) {
 	fundamentals := formatFundamentalsForAI(data)
 	prompt := fmt.Sprintf(`Analyze these fundamentals for %s (3-4 sentences):
 
@@ -216,11 +305,16 @@ func (ai *AIClient) AnalyzeFundamentals(data StockAnalysisData) (string, error)
 
 Focus on: profitability trends, financial health, and key ratios.`, data.Symbol, fundamentals)
 
-	return ai.chat(prompt)
+	return ai.chat(ctx, prompt)
 }
 
 // AssessRisks identifies potential risks
 func (ai *AIClient) AssessRisks(data StockAnalysisData) (string, error) {
+	return ai.AssessRisksCtx(context.Background(), data)
+}
+
+// AssessRisksCtx is like AssessRisks but aborts when ctx is done
+func (ai *AIClient) AssessRisksCtx(ctx context.Context, data StockAnalysisData) (string, error) {
 	riskData := formatRiskDataForAI(data)
 	prompt := fmt.Sprintf(`Identify key risks for %s based on this data (3-4 bullet points):
 
@@ -228,22 +322,32 @@ func (ai *AIClient) AssessRisks(data StockAnalysisData) (string, error) {
 
 Focus on: financial risks, market risks, and operational concerns.`, data.Symbol, riskData)
 
-	return ai.chat(prompt)
+	return ai.chat(ctx, prompt)
 }
 
 // GenerateOutlook provides future outlook
 func (ai *AIClient) GenerateOutlook(data StockAnalysisData) (string, error) {
+	return ai.GenerateOutlookCtx(context.Background(), data)
+}
+
+// GenerateOutlookCtx is like GenerateOutlook but aborts when ctx is done
+func (ai *AIClient) GenerateOutlookCtx(ctx context.Context, data StockAnalysisData) (string, error) {
 	prompt := fmt.Sprintf(`Based on this data for %s, provide a brief outlook (2-3 sentences):
 
 %s
 
 Be balanced and note this is not financial advice.`, data.Symbol, formatDataForAI(data))
 
-	return ai.chat(prompt)
+	return ai.chat(ctx, prompt)
 }
 
 // SummarizeNews summarizes recent news sentiment
 func (ai *AIClient) SummarizeNews(data *NewsSentimentResponse) (string, error) {
+	return ai.SummarizeNewsCtx(context.Background(), data)
+}
+
+// SummarizeNewsCtx is like SummarizeNews but aborts when ctx is done
+func (ai *AIClient) SummarizeNewsCtx(ctx context.Context, data *NewsSentimentResponse) (string, error) {
 	if data == nil || len(data.Feed) == 0 {
 		return "", fmt.Errorf("no news data")
 	}
@@ -255,11 +359,39 @@ func (ai *AIClient) SummarizeNews(data *NewsSentimentResponse) (string, error) {
 
 Focus on: overall sentiment, key themes, and potential market impact.`, newsData)
 
-	return ai.chat(prompt)
+	return ai.chat(ctx, prompt)
+}
+
+// AnalyzeEarningsCall summarizes data.Transcript and contrasts management
+// tone with the reported numbers for the same quarter from data.Earnings.
+// data.Overview, when set, supplies the fiscal year end for that match
+func (ai *AIClient) AnalyzeEarningsCall(data StockAnalysisData) (string, error) {
+	return ai.AnalyzeEarningsCallCtx(context.Background(), data)
+}
+
+// AnalyzeEarningsCallCtx is like AnalyzeEarningsCall but aborts when ctx is done
+func (ai *AIClient) AnalyzeEarningsCallCtx(ctx context.Context, data StockAnalysisData) (string, error) {
+	if data.Transcript == nil || len(data.Transcript.Transcript) == 0 {
+		return "", fmt.Errorf("no transcript data")
+	}
+
+	prompt := fmt.Sprintf(`Summarize this %s earnings call for %s and contrast management's tone with the reported numbers (4-5 sentences):
+
+%s
+
+Focus on: key messages and guidance, whether management sounds more or less confident than the results justify, and what analysts pushed back on.`,
+		data.Transcript.Quarter, data.Symbol, formatTranscriptForAI(data))
+
+	return ai.chat(ctx, prompt)
 }
 
 // CustomAnalysis allows custom prompts with stock data
 func (ai *AIClient) CustomAnalysis(data StockAnalysisData, customPrompt string) (string, error) {
+	return ai.CustomAnalysisCtx(context.Background(), data, customPrompt)
+}
+
+// CustomAnalysisCtx is like CustomAnalysis but aborts when ctx is done
+func (ai *AIClient) CustomAnalysisCtx(ctx context.Context, data StockAnalysisData, customPrompt string) (string, error) {
 	fullPrompt := fmt.Sprintf(`Stock: %s
 
 Data:
@@ -267,7 +399,7 @@ Data:
 
 User Request: %s`, data.Symbol, formatDataForAI(data), customPrompt)
 
-	return ai.chat(fullPrompt)
+	return ai.chat(ctx, fullPrompt)
 }
 
 
@@ -276,9 +408,15 @@ User Request: %s`, data.Symbol, formatDataForAI(data), customPrompt)
 func formatDataForAI(data StockAnalysisData) string {
 	var sb strings.Builder
 
+	// Company profile and valuation
+	if data.Overview != nil {
+		sb.WriteString(formatOverviewForAI(data.Overview))
+		sb.WriteString("\n")
+	}
+
 	// Price summary
 	if data.Daily != nil && len(data.Daily.TimeSeries) > 0 {
-		sb.WriteString(extractPriceSummary(data.Daily))
+		sb.WriteString(extractPriceSummary(data.prices()))
 		sb.WriteString("\n\n")
 	}
 
@@ -293,6 +431,17 @@ func formatDataForAI(data StockAnalysisData) string {
 		sb.WriteString("\n")
 	}
 
+	// Income Statement
+	if data.Income != nil && len(data.Income.AnnualReports) > 0 {
+		r := data.Income.AnnualReports[0]
+		m := r.Margins()
+		sb.WriteString(fmt.Sprintf("INCOME STATEMENT (%s):\n", r.FiscalDateEnding))
+		sb.WriteString(fmt.Sprintf("  Revenue: %s\n", formatNum(r.TotalRevenue)))
+		sb.WriteString(fmt.Sprintf("  Operating Income: %s\n", formatNum(r.OperatingIncome)))
+		sb.WriteString(fmt.Sprintf("  Net Income: %s\n", formatNum(r.NetIncome)))
+		sb.WriteString(fmt.Sprintf("  Net Margin: %.1f%%\n\n", m.Net*100))
+	}
+
 	// Cash Flow
 	if data.CashFlow != nil && len(data.CashFlow.AnnualReports) > 0 {
 		r := data.CashFlow.AnnualReports[0]
@@ -396,6 +545,15 @@ func extractPriceSummary(data *TimeSeriesDailyResponse) string {
 func formatFundamentalsForAI(data StockAnalysisData) string {
 	var sb strings.Builder
 
+	// Valuation and profitability
+	if data.Overview != nil {
+		o := data.Overview
+		sb.WriteString("VALUATION:\n")
+		sb.WriteString(fmt.Sprintf("  P/E: %s, Forward P/E: %s, PEG: %s\n", orNA(o.PERatio), orNA(o.ForwardPE), orNA(o.PEGRatio)))
+		sb.WriteString(fmt.Sprintf("  Price/Book: %s, Price/Sales: %s, EV/EBITDA: %s\n", orNA(o.PriceToBookRatio), orNA(o.PriceToSalesRatioTTM), orNA(o.EVToEBITDA)))
+		sb.WriteString(fmt.Sprintf("  Profit Margin: %s, Operating Margin: %s, ROE: %s\n\n", formatPercent(o.ProfitMargin), formatPercent(o.OperatingMarginTTM), formatPercent(o.ReturnOnEquityTTM)))
+	}
+
 	// Earnings trend
 	if data.Earnings != nil && len(data.Earnings.AnnualEarnings) >= 3 {
 		sb.WriteString("EPS TREND:\n")
@@ -406,6 +564,18 @@ func formatFundamentalsForAI(data StockAnalysisData) string {
 		sb.WriteString("\n")
 	}
 
+	// Margin trend
+	if data.Income != nil && len(data.Income.AnnualReports) > 0 {
+		sb.WriteString("MARGINS:\n")
+		for i := 0; i < min(3, len(data.Income.AnnualReports)); i++ {
+			r := data.Income.AnnualReports[i]
+			m := r.Margins()
+			sb.WriteString(fmt.Sprintf("  %s: Revenue %s, Gross %.1f%%, Operating %.1f%%, Net %.1f%%\n",
+				r.FiscalDateEnding, formatNum(r.TotalRevenue), m.Gross*100, m.Operating*100, m.Net*100))
+		}
+		sb.WriteString("\n")
+	}
+
 	// Cash flow health
 	if data.CashFlow != nil && len(data.CashFlow.AnnualReports) > 0 {
 		r := data.CashFlow.AnnualReports[0]
@@ -439,6 +609,14 @@ func formatFundamentalsForAI(data StockAnalysisData) string {
 func formatRiskDataForAI(data StockAnalysisData) string {
 	var sb strings.Builder
 
+	// Market sensitivity
+	if data.Overview != nil {
+		o := data.Overview
+		sb.WriteString(fmt.Sprintf("Beta: %s\n", orNA(o.Beta)))
+		sb.WriteString(fmt.Sprintf("52-Week Range: $%s - $%s\n", orNA(o.Week52Low), orNA(o.Week52High)))
+		sb.WriteString(fmt.Sprintf("Quarterly Earnings Growth YoY: %s\n", formatPercent(o.QuarterlyEarningsGrowthYOY)))
+	}
+
 	// Debt levels
 	if data.BalanceSheet != nil && len(data.BalanceSheet.AnnualReports) > 0 {
 		r := data.BalanceSheet.AnnualReports[0]
@@ -495,6 +673,59 @@ func formatNewsForAI(data *NewsSentimentResponse) string {
 	return sb.String()
 }
 
+func formatTranscriptForAI(data StockAnalysisData) string {
+	t := data.Transcript
+	var sb strings.Builder
+
+	fiscalYearEnd := ""
+	if data.Overview != nil {
+		fiscalYearEnd = data.Overview.FiscalYearEnd
+	}
+	sb.WriteString(fmt.Sprintf("REPORTED NUMBERS (%s):\n", t.Quarter))
+	if q := FindQuarterlyEarning(data.Earnings, t.Quarter, fiscalYearEnd); q != nil {
+		sb.WriteString(fmt.Sprintf("  Quarter ending %s, reported %s\n", q.FiscalDateEnding, q.ReportedDate))
+		sb.WriteString(fmt.Sprintf("  EPS: %s vs estimate %s (surprise %s%%)\n", q.ReportedEPS, q.EstimatedEPS, q.SurprisePercentage))
+	} else {
+		sb.WriteString("  No earnings data for this quarter\n")
+	}
+
+	management, others := t.Tone()
+	sb.WriteString(fmt.Sprintf("\nSENTIMENT (-1 to 1): management %.2f, analysts and operator %.2f\n", management, others))
+
+	sb.WriteString("\nSPEAKERS:\n")
+	for _, sp := range t.Speakers() {
+		role := "other"
+		if sp.Management {
+			role = "management"
+		}
+		sb.WriteString(fmt.Sprintf("- %s, %s (%s): %d turns, %d words, sentiment %.2f\n",
+			sp.Name, sp.Title, role, sp.Segments, sp.Words, sp.Sentiment))
+	}
+
+	// Whole transcripts run to tens of thousands of words, so keep the
+	// start of each turn
+	sb.WriteString("\nTRANSCRIPT:\n")
+	count := min(40, len(t.Transcript))
+	for i := 0; i < count; i++ {
+		s := t.Transcript[i]
+		sb.WriteString(fmt.Sprintf("%s (%s, sentiment %s): %s\n", s.Speaker, s.Title, s.Sentiment, truncate(s.Content, 600)))
+	}
+
+	return sb.String()
+}
+
+func formatOverviewForAI(o *CompanyOverview) string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("COMPANY: %s (%s), %s\n", o.Name, o.Symbol, o.Exchange))
+	sb.WriteString(fmt.Sprintf("  Sector: %s, Industry: %s\n", o.Sector, o.Industry))
+	sb.WriteString(fmt.Sprintf("  Market Cap: %s\n", formatNum(o.MarketCapitalization)))
+	sb.WriteString(fmt.Sprintf("  P/E: %s, PEG: %s, Beta: %s\n", orNA(o.PERatio), orNA(o.PEGRatio), orNA(o.Beta)))
+	sb.WriteString(fmt.Sprintf("  Dividend Yield: %s\n", formatPercent(o.DividendYield)))
+	sb.WriteString(fmt.Sprintf("  52-Week Range: $%s - $%s\n", orNA(o.Week52Low), orNA(o.Week52High)))
+	sb.WriteString(fmt.Sprintf("  Analyst Target: $%s\n", orNA(o.AnalystTargetPrice)))
+	return sb.String()
+}
+
 func formatNum(s string) string {
 	if s == "" || s == "None" {
 		return "N/A"
@@ -0,0 +1,1901 @@
+package avtest
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/rand"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/SwanHtetAungPhyo/alphavintage"
+)
+
+// handler builds the payload for one function. A nil payload answers
+// with errMessage as an "Error Message"
+type handler func(q url.Values) (payload interface{}, errMessage string)
+
+var handlers = map[string]handler{
+	"MARKET_STATUS":        marketStatus,
+	"TOP_GAINERS_LOSERS":   topGainersLosers,
+	"TIME_SERIES_DAILY":    timeSeriesDaily,
+	"TIME_SERIES_INTRADAY": timeSeriesIntraday,
+
+	"TIME_SERIES_DAILY_ADJUSTED":   timeSeriesDailyAdjusted,
+	"TIME_SERIES_WEEKLY":           periodSeries("Weekly", false),
+	"TIME_SERIES_WEEKLY_ADJUSTED":  periodSeries("Weekly", true),
+	"TIME_SERIES_MONTHLY":          periodSeries("Monthly", false),
+	"TIME_SERIES_MONTHLY_ADJUSTED": periodSeries("Monthly", true),
+
+	"GLOBAL_QUOTE":         globalQuote,
+	"REALTIME_BULK_QUOTES": bulkQuotes,
+
+	"SYMBOL_SEARCH":  symbolSearch,
+	"LISTING_STATUS": listingStatus,
+
+	"CURRENCY_EXCHANGE_RATE": exchangeRate,
+	"FX_INTRADAY":            fxIntraday,
+	"FX_DAILY":               fxSeries("Daily"),
+	"FX_WEEKLY":              fxSeries("Weekly"),
+	"FX_MONTHLY":             fxSeries("Monthly"),
+
+	"DIGITAL_CURRENCY_DAILY":   digitalCurrency("Daily"),
+	"DIGITAL_CURRENCY_WEEKLY":  digitalCurrency("Weekly"),
+	"DIGITAL_CURRENCY_MONTHLY": digitalCurrency("Monthly"),
+	"CRYPTO_INTRADAY":          cryptoIntraday,
+
+	"REAL_GDP":            economic("Real Gross Domestic Product", "billions of dollars", 22000, 0.005, "annual", "quarterly"),
+	"REAL_GDP_PER_CAPITA": economic("Real Gross Domestic Product per Capita", "chained 2012 dollars", 67000, 0.005, "quarterly"),
+	"TREASURY_YIELD":      treasuryYield,
+	"FEDERAL_FUNDS_RATE":  economic("Effective Federal Funds Rate", "percent", 4.5, 0.02, "monthly", "daily", "weekly"),
+	"CPI":                 economic("Consumer Price Index for all Urban Consumers", "index 1982-1984=100", 315, 0.003, "monthly", "semiannual"),
+	"INFLATION":           economic("Inflation - US Consumer Prices", "percent", 3.2, 0.08, "annual"),
+	"RETAIL_SALES":        economic("Advance Retail Sales: Retail Trade", "millions of dollars", 720000, 0.01, "monthly"),
+	"DURABLES":            economic("Manufacturer New Orders: Durable Goods", "millions of dollars", 285000, 0.02, "monthly"),
+	"UNEMPLOYMENT":        economic("Unemployment Rate", "percent", 4.2, 0.03, "monthly"),
+	"NONFARM_PAYROLL":     economic("Total Nonfarm Payroll", "thousands of persons", 159000, 0.002, "monthly"),
+
+	"WTI":             economic("Crude Oil Prices WTI", "dollars per barrel", 69.5, 0.02, "monthly", "daily", "weekly"),
+	"BRENT":           economic("Crude Oil Prices Brent", "dollars per barrel", 72.9, 0.02, "monthly", "daily", "weekly"),
+	"NATURAL_GAS":     economic("Henry Hub Natural Gas Spot Price", "dollars per million BTU", 3.4, 0.04, "monthly", "daily", "weekly"),
+	"COPPER":          economic("Global Price of Copper", "dollars per metric ton", 9100, 0.02, "monthly", "quarterly", "annual"),
+	"ALUMINUM":        economic("Global Price of Aluminum", "dollars per metric ton", 2550, 0.02, "monthly", "quarterly", "annual"),
+	"WHEAT":           economic("Global Price of Wheat", "dollars per metric ton", 240, 0.03, "monthly", "quarterly", "annual"),
+	"CORN":            economic("Global Price of Corn", "dollars per metric ton", 205, 0.03, "monthly", "quarterly", "annual"),
+	"COTTON":          economic("Global Price of Cotton", "cents per pound", 78, 0.03, "monthly", "quarterly", "annual"),
+	"SUGAR":           economic("Global Price of Sugar", "cents per pound", 21, 0.04, "monthly", "quarterly", "annual"),
+	"COFFEE":          economic("Global Price of Coffee", "cents per pound", 320, 0.04, "monthly", "quarterly", "annual"),
+	"ALL_COMMODITIES": economic("Global Price Index of All Commodities", "index 2016=100", 165, 0.02, "monthly", "quarterly", "annual"),
+
+	"SMA":    indicator("Simple Moving Average (SMA)", true, true, sma),
+	"EMA":    indicator("Exponential Moving Average (EMA)", true, true, ema),
+	"RSI":    indicator("Relative Strength Index (RSI)", true, true, rsi),
+	"BBANDS": indicator("Bollinger Bands (BBANDS)", true, true, bbands),
+	"MACD":   indicator("Moving Average Convergence/Divergence (MACD)", false, true, macd),
+	"STOCH":  indicator("Stochastic (STOCH)", false, false, stoch),
+	"OBV":    indicator("On Balance Volume (OBV)", false, false, obv),
+
+	"REALTIME_OPTIONS":   options(true),
+	"HISTORICAL_OPTIONS": options(false),
+
+	"DIVIDENDS": dividendHistory,
+	"SPLITS":    splitHistory,
+
+	"INSIDER_TRANSACTIONS":     insiderTransactions,
+	"EARNINGS_CALL_TRANSCRIPT": earningsCallTranscript,
+
+	"EARNINGS_CALENDAR": earningsCalendar,
+	"IPO_CALENDAR":      ipoCalendar,
+
+	"OVERVIEW":         overview,
+	"BALANCE_SHEET":    balanceSheet,
+	"INCOME_STATEMENT": incomeStatement,
+	"CASH_FLOW":        cashFlow,
+	"EARNINGS":         earnings,
+	"NEWS_SENTIMENT":   newsSentiment,
+}
+
+// csvPayload is served as-is instead of being encoded as JSON
+type csvPayload string
+
+// Helpers
+
+func requireSymbol(q url.Values) (string, string) {
+	symbol := strings.ToUpper(q.Get("symbol"))
+	if symbol == "" {
+		return "", invalidCall(q.Get("function"))
+	}
+	return symbol, ""
+}
+
+// seeded returns a random source that is stable for a symbol
+func seeded(symbol string) *rand.Rand {
+	h := fnv.New64a()
+	h.Write([]byte(symbol))
+	return rand.New(rand.NewSource(int64(h.Sum64())))
+}
+
+// scale returns a per-symbol size factor used for fundamentals, in dollars
+func scale(symbol string) float64 {
+	return 1e9 * (5 + seeded(symbol).Float64()*95)
+}
+
+// tradingDays returns n weekdays ending at AsOf, oldest first
+func tradingDays(n int) []time.Time {
+	day, _ := time.Parse("2006-01-02", AsOf)
+	days := make([]time.Time, 0, n)
+	for len(days) < n {
+		if day.Weekday() != time.Saturday && day.Weekday() != time.Sunday {
+			days = append(days, day)
+		}
+		day = day.AddDate(0, 0, -1)
+	}
+	for i, j := 0, len(days)-1; i < j; i, j = i+1, j-1 {
+		days[i], days[j] = days[j], days[i]
+	}
+	return days
+}
+
+type bar struct {
+	open, high, low, close float64
+	volume                 int64
+}
+
+// priceWalk returns n bars of a random walk, oldest first
+func priceWalk(symbol string, n int, volatility float64) []bar {
+	rnd := seeded(symbol)
+	price := 50 + rnd.Float64()*200
+	bars := make([]bar, n)
+	for i := range bars {
+		open := price
+		price *= 1 + rnd.NormFloat64()*volatility
+		high := math.Max(open, price) * (1 + rnd.Float64()*volatility/2)
+		low := math.Min(open, price) * (1 - rnd.Float64()*volatility/2)
+		bars[i] = bar{open, high, low, price, 1e6 + rnd.Int63n(9e6)}
+	}
+	return bars
+}
+
+// dailyBars returns the full daily history of a symbol. Every daily,
+// weekly and monthly series is cut from it, so they agree with each other
+func dailyBars(symbol string) ([]time.Time, []bar) {
+	return tradingDays(1000), priceWalk(symbol, 1000, 0.015)
+}
+
+// adjustedBars adds a quarterly dividend to a walk and returns, per bar,
+// the dividend paid that day and the factor that adjusts its prices for
+// all later dividends
+func adjustedBars(bars []bar) (dividends, factors []float64) {
+	dividends = make([]float64, len(bars))
+	factors = make([]float64, len(bars))
+	factor := 1.0
+	for i := len(bars) - 1; i >= 0; i-- {
+		factors[i] = factor
+		if i > 0 && i%63 == 0 {
+			dividends[i] = bars[i-1].close * 0.006
+			factor *= 1 - dividends[i]/bars[i-1].close
+		}
+	}
+	return dividends, factors
+}
+
+func adjustedOHLCV(b bar, dividend, factor float64, withSplit bool) map[string]string {
+	point := map[string]string{
+		"1. open":            money(b.open),
+		"2. high":            money(b.high),
+		"3. low":             money(b.low),
+		"4. close":           money(b.close),
+		"5. adjusted close":  money(b.close * factor),
+		"6. volume":          fmt.Sprintf("%d", b.volume),
+		"7. dividend amount": money(dividend),
+	}
+	if withSplit {
+		point["8. split coefficient"] = "1.0"
+	}
+	return point
+}
+
+func ohlcv(b bar) map[string]string {
+	return map[string]string{
+		"1. open":   money(b.open),
+		"2. high":   money(b.high),
+		"3. low":    money(b.low),
+		"4. close":  money(b.close),
+		"5. volume": fmt.Sprintf("%d", b.volume),
+	}
+}
+
+func money(v float64) string {
+	return fmt.Sprintf("%.4f", v)
+}
+
+func amount(v float64) string {
+	return fmt.Sprintf("%.0f", v)
+}
+
+func outputSize(q url.Values, compact, full int) (int, string) {
+	if q.Get("outputsize") == "full" {
+		return full, "Full size"
+	}
+	return compact, "Compact"
+}
+
+// fillNone sets every empty string field to "None", which is what
+// Alpha Vantage reports for values a company doesn't disclose
+func fillNone(v interface{}) {
+	rv := reflect.ValueOf(v).Elem()
+	for i := 0; i < rv.NumField(); i++ {
+		f := rv.Field(i)
+		if f.Kind() == reflect.String && f.String() == "" {
+			f.SetString("None")
+		}
+	}
+}
+
+// fiscalYears returns the last n fiscal year ends before AsOf, newest first
+func fiscalYears(n int) []string {
+	asOf, _ := time.Parse("2006-01-02", AsOf)
+	out := make([]string, n)
+	for i := range out {
+		out[i] = fmt.Sprintf("%d-12-31", asOf.Year()-1-i)
+	}
+	return out
+}
+
+// fiscalQuarters returns the last n quarter ends before AsOf, newest first
+func fiscalQuarters(n int) []string {
+	asOf, _ := time.Parse("2006-01-02", AsOf)
+	q := time.Date(asOf.Year(), ((asOf.Month()-1)/3)*3+1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+	out := make([]string, n)
+	for i := range out {
+		out[i] = q.Format("2006-01-02")
+		q = time.Date(q.Year(), q.Month()-2, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+	}
+	return out
+}
+
+// Handlers
+
+func marketStatus(q url.Values) (interface{}, string) {
+	market := func(marketType, region, exchanges, open, close, status string) alphavintage.Market {
+		return alphavintage.Market{
+			MarketType:       marketType,
+			Region:           region,
+			PrimaryExchanges: exchanges,
+			LocalOpen:        open,
+			LocalClose:       close,
+			CurrentStatus:    status,
+		}
+	}
+	return alphavintage.MarketStatusResponse{
+		Endpoint: "Global Market Open & Close Status",
+		Markets: []alphavintage.Market{
+			market("Equity", "United States", "NASDAQ, NYSE, AMEX, BATS", "09:30", "16:00", "open"),
+			market("Equity", "Canada", "Toronto, Toronto Ventures", "09:30", "16:00", "open"),
+			market("Equity", "United Kingdom", "London", "08:00", "16:30", "closed"),
+			market("Equity", "Germany", "XETRA, Berlin, Frankfurt, Munich, Stuttgart", "08:00", "20:00", "closed"),
+			market("Equity", "Japan", "Tokyo", "09:00", "15:00", "closed"),
+			market("Equity", "Hong Kong", "Hong Kong", "09:30", "16:00", "closed"),
+			market("Forex", "Global", "Global", "00:00", "23:59", "open"),
+			market("Cryptocurrency", "Global", "Global", "00:00", "23:59", "open"),
+		},
+	}, ""
+}
+
+func timeSeriesDaily(q url.Values) (interface{}, string) {
+	symbol, errMessage := requireSymbol(q)
+	if errMessage != "" {
+		return nil, errMessage
+	}
+	n, size := outputSize(q, 100, 1000)
+
+	days, bars := dailyBars(symbol)
+	series := make(map[string]interface{}, n)
+	for i := len(days) - n; i < len(days); i++ {
+		series[days[i].Format("2006-01-02")] = ohlcv(bars[i])
+	}
+
+	return map[string]interface{}{
+		"Meta Data": map[string]string{
+			"1. Information":    "Daily Prices (open, high, low, close) and Volumes",
+			"2. Symbol":         symbol,
+			"3. Last Refreshed": AsOf,
+			"4. Output Size":    size,
+			"5. Time Zone":      "US/Eastern",
+		},
+		"Time Series (Daily)": series,
+	}, ""
+}
+
+func timeSeriesDailyAdjusted(q url.Values) (interface{}, string) {
+	symbol, errMessage := requireSymbol(q)
+	if errMessage != "" {
+		return nil, errMessage
+	}
+	n, size := outputSize(q, 100, 1000)
+
+	days, bars := dailyBars(symbol)
+	dividends, factors := adjustedBars(bars)
+	series := make(map[string]interface{}, n)
+	for i := len(days) - n; i < len(days); i++ {
+		series[days[i].Format("2006-01-02")] = adjustedOHLCV(bars[i], dividends[i], factors[i], true)
+	}
+
+	return map[string]interface{}{
+		"Meta Data": map[string]string{
+			"1. Information":    "Daily Time Series with Splits and Dividend Events",
+			"2. Symbol":         symbol,
+			"3. Last Refreshed": AsOf,
+			"4. Output Size":    size,
+			"5. Time Zone":      "US/Eastern",
+		},
+		"Time Series (Daily)": series,
+	}, ""
+}
+
+// periodSeries serves weekly or monthly bars built from the daily walk,
+// each keyed by the last trading day of its period
+func periodSeries(period string, adjusted bool) handler {
+	return func(q url.Values) (interface{}, string) {
+		symbol, errMessage := requireSymbol(q)
+		if errMessage != "" {
+			return nil, errMessage
+		}
+
+		days, bars := dailyBars(symbol)
+		dividends, factors := adjustedBars(bars)
+
+		periodOf := func(t time.Time) string {
+			if period == "Weekly" {
+				year, week := t.ISOWeek()
+				return fmt.Sprintf("%d-%02d", year, week)
+			}
+			return t.Format("2006-01")
+		}
+
+		series := make(map[string]interface{})
+		for start := 0; start < len(days); {
+			end := start
+			for end+1 < len(days) && periodOf(days[end+1]) == periodOf(days[start]) {
+				end++
+			}
+
+			agg := bar{open: bars[start].open, high: bars[start].high, low: bars[start].low, close: bars[end].close}
+			var dividend float64
+			for i := start; i <= end; i++ {
+				agg.high = math.Max(agg.high, bars[i].high)
+				agg.low = math.Min(agg.low, bars[i].low)
+				agg.volume += bars[i].volume
+				dividend += dividends[i]
+			}
+
+			date := days[end].Format("2006-01-02")
+			if adjusted {
+				series[date] = adjustedOHLCV(agg, dividend, factors[end], false)
+			} else {
+				series[date] = ohlcv(agg)
+			}
+			start = end + 1
+		}
+
+		info := period + " Prices (open, high, low, close) and Volumes"
+		key := period + " Time Series"
+		if adjusted {
+			info = period + " Adjusted Prices and Volumes"
+			key = period + " Adjusted Time Series"
+		}
+		return map[string]interface{}{
+			"Meta Data": map[string]string{
+				"1. Information":    info,
+				"2. Symbol":         symbol,
+				"3. Last Refreshed": AsOf,
+				"4. Time Zone":      "US/Eastern",
+			},
+			key: series,
+		}, ""
+	}
+}
+
+// lastQuote returns the latest two daily bars of a symbol
+func lastQuote(symbol string) (last, prev bar) {
+	_, bars := dailyBars(symbol)
+	return bars[len(bars)-1], bars[len(bars)-2]
+}
+
+func globalQuote(q url.Values) (interface{}, string) {
+	symbol, errMessage := requireSymbol(q)
+	if errMessage != "" {
+		return nil, errMessage
+	}
+	last, prev := lastQuote(symbol)
+
+	return map[string]interface{}{
+		"Global Quote": alphavintage.GlobalQuote{
+			Symbol:           symbol,
+			Open:             money(last.open),
+			High:             money(last.high),
+			Low:              money(last.low),
+			Price:            money(last.close),
+			Volume:           fmt.Sprintf("%d", last.volume),
+			LatestTradingDay: AsOf,
+			PreviousClose:    money(prev.close),
+			Change:           money(last.close - prev.close),
+			ChangePercent:    fmt.Sprintf("%.4f%%", (last.close-prev.close)/prev.close*100),
+		},
+	}, ""
+}
+
+func bulkQuotes(q url.Values) (interface{}, string) {
+	if q.Get("symbol") == "" {
+		return nil, invalidCall(q.Get("function"))
+	}
+
+	resp := alphavintage.BulkQuotesResponse{
+		Endpoint: "Realtime Bulk Quotes",
+	}
+	for _, symbol := range strings.Split(strings.ToUpper(q.Get("symbol")), ",") {
+		last, prev := lastQuote(symbol)
+		resp.Data = append(resp.Data, alphavintage.BulkQuote{
+			Symbol:        symbol,
+			Timestamp:     AsOf + " 16:00:00.000",
+			Open:          money(last.open),
+			High:          money(last.high),
+			Low:           money(last.low),
+			Close:         money(last.close),
+			Volume:        fmt.Sprintf("%d", last.volume),
+			PreviousClose: money(prev.close),
+			Change:        money(last.close - prev.close),
+			ChangePercent: fmt.Sprintf("%.4f", (last.close-prev.close)/prev.close*100),
+		})
+	}
+	return resp, ""
+}
+
+var intervalMinutes = map[string]int{"1min": 1, "5min": 5, "15min": 15, "30min": 30, "60min": 60}
+
+func timeSeriesIntraday(q url.Values) (interface{}, string) {
+	symbol, errMessage := requireSymbol(q)
+	if errMessage != "" {
+		return nil, errMessage
+	}
+	interval := q.Get("interval")
+	minutes, ok := intervalMinutes[interval]
+	if !ok {
+		return nil, invalidCall(q.Get("function"))
+	}
+
+	// Regular session of the last two trading days, 09:30 to 16:00
+	var stamps []string
+	for _, day := range tradingDays(2) {
+		start := day.Add(9*time.Hour + 30*time.Minute)
+		for t := start.Add(time.Duration(minutes) * time.Minute); !t.After(day.Add(16 * time.Hour)); t = t.Add(time.Duration(minutes) * time.Minute) {
+			stamps = append(stamps, t.Format("2006-01-02 15:04:05"))
+		}
+	}
+	n, size := outputSize(q, 100, len(stamps))
+	if n > len(stamps) {
+		n = len(stamps)
+	}
+	stamps = stamps[len(stamps)-n:]
+
+	bars := priceWalk(symbol+interval, len(stamps), 0.002)
+	series := make(map[string]interface{}, len(stamps))
+	for i, stamp := range stamps {
+		b := bars[i]
+		b.volume /= 100
+		series[stamp] = ohlcv(b)
+	}
+
+	return map[string]interface{}{
+		"Meta Data": map[string]string{
+			"1. Information":    fmt.Sprintf("Intraday (%s) open, high, low, close prices and volume", interval),
+			"2. Symbol":         symbol,
+			"3. Last Refreshed": stamps[len(stamps)-1],
+			"4. Interval":       interval,
+			"5. Output Size":    size,
+			"6. Time Zone":      "US/Eastern",
+		},
+		fmt.Sprintf("Time Series (%s)", interval): series,
+	}, ""
+}
+
+var sectors = [][2]string{
+	{"TECHNOLOGY", "SERVICES-PREPACKAGED SOFTWARE"},
+	{"MANUFACTURING", "ELECTRONIC COMPUTERS"},
+	{"FINANCE", "NATIONAL COMMERCIAL BANKS"},
+	{"LIFE SCIENCES", "PHARMACEUTICAL PREPARATIONS"},
+	{"ENERGY & TRANSPORTATION", "PETROLEUM REFINING"},
+}
+
+func overview(q url.Values) (interface{}, string) {
+	symbol, errMessage := requireSymbol(q)
+	if errMessage != "" {
+		return nil, errMessage
+	}
+	rnd := seeded(symbol)
+	base := scale(symbol)
+	last, _ := lastQuote(symbol)
+	_, bars := dailyBars(symbol)
+	sector := sectors[rnd.Intn(len(sectors))]
+
+	high, low := last.high, last.low
+	for _, b := range bars[len(bars)-252:] {
+		high = math.Max(high, b.high)
+		low = math.Min(low, b.low)
+	}
+	average := func(n int) float64 {
+		sum := 0.0
+		for _, b := range bars[len(bars)-n:] {
+			sum += b.close
+		}
+		return sum / float64(n)
+	}
+
+	// Size the share count so the stock trades at 4x sales and ~16x earnings
+	shares := base * 0.6 * 4 / last.close
+	eps := base * 0.15 / shares
+	ratio := func(v float64) string { return fmt.Sprintf("%.3f", v) }
+	o := alphavintage.CompanyOverview{
+		Symbol:                     symbol,
+		AssetType:                  "Common Stock",
+		Name:                       symbol + " Corporation",
+		Description:                symbol + " Corporation is a fictional company generated by the avtest fake server.",
+		CIK:                        fmt.Sprintf("%07d", rnd.Intn(2000000)),
+		Exchange:                   "NYSE",
+		Currency:                   "USD",
+		Country:                    "USA",
+		Sector:                     sector[0],
+		Industry:                   sector[1],
+		Address:                    "1 MAIN STREET, ANYTOWN, NY, US",
+		OfficialSite:               "https://www." + strings.ToLower(symbol) + ".example.com",
+		FiscalYearEnd:              "December",
+		LatestQuarter:              fiscalQuarters(1)[0],
+		MarketCapitalization:       amount(shares * last.close),
+		EBITDA:                     amount(base * 0.25),
+		PERatio:                    ratio(last.close / eps),
+		PEGRatio:                   ratio(0.5 + rnd.Float64()*2),
+		BookValue:                  ratio(base / shares),
+		DividendPerShare:           ratio(last.close * 0.024),
+		DividendYield:              fmt.Sprintf("%.4f", 0.024),
+		EPS:                        ratio(eps),
+		RevenuePerShareTTM:         ratio(base * 0.6 / shares),
+		ProfitMargin:               fmt.Sprintf("%.4f", 0.15/0.6),
+		OperatingMarginTTM:         fmt.Sprintf("%.4f", 0.3),
+		ReturnOnAssetsTTM:          fmt.Sprintf("%.4f", 0.05),
+		ReturnOnEquityTTM:          fmt.Sprintf("%.4f", 0.15),
+		RevenueTTM:                 amount(base * 0.6),
+		GrossProfitTTM:             amount(base * 0.35),
+		DilutedEPSTTM:              ratio(eps),
+		QuarterlyEarningsGrowthYOY: fmt.Sprintf("%.3f", rnd.NormFloat64()*0.1),
+		QuarterlyRevenueGrowthYOY:  fmt.Sprintf("%.3f", rnd.NormFloat64()*0.05),
+		AnalystTargetPrice:         money(last.close * (1 + rnd.NormFloat64()*0.1)),
+		AnalystRatingStrongBuy:     fmt.Sprintf("%d", rnd.Intn(8)),
+		AnalystRatingBuy:           fmt.Sprintf("%d", rnd.Intn(12)),
+		AnalystRatingHold:          fmt.Sprintf("%d", rnd.Intn(10)),
+		AnalystRatingSell:          fmt.Sprintf("%d", rnd.Intn(3)),
+		AnalystRatingStrongSell:    fmt.Sprintf("%d", rnd.Intn(2)),
+		TrailingPE:                 ratio(last.close / eps),
+		ForwardPE:                  ratio(last.close / eps * 0.9),
+		PriceToSalesRatioTTM:       ratio(shares * last.close / (base * 0.6)),
+		PriceToBookRatio:           ratio(last.close / (base / shares)),
+		EVToRevenue:                ratio(shares * last.close / (base * 0.6) * 1.1),
+		EVToEBITDA:                 ratio(shares * last.close / (base * 0.25) * 1.1),
+		Beta:                       ratio(0.6 + rnd.Float64()),
+		Week52High:                 money(high),
+		Week52Low:                  money(low),
+		MovingAverage50Day:         money(average(50)),
+		MovingAverage200Day:        money(average(200)),
+		SharesOutstanding:          amount(shares),
+		SharesFloat:                amount(shares * 0.99),
+		PercentInsiders:            "0.120",
+		PercentInstitutions:        "62.500",
+		DividendDate:               "2024-12-10",
+		ExDividendDate:             "2024-11-12",
+	}
+	return o, ""
+}
+
+func balanceSheetReport(base float64, date string) alphavintage.BalanceSheetReport {
+	r := alphavintage.BalanceSheetReport{
+		FiscalDateEnding:                      date,
+		ReportedCurrency:                      "USD",
+		TotalAssets:                           amount(base * 3),
+		TotalCurrentAssets:                    amount(base * 1.1),
+		CashAndCashEquivalentsAtCarryingValue: amount(base * 0.3),
+		CashAndShortTermInvestments:           amount(base * 0.45),
+		Inventory:                             amount(base * 0.12),
+		CurrentNetReceivables:                 amount(base * 0.25),
+		TotalNonCurrentAssets:                 amount(base * 1.9),
+		PropertyPlantEquipment:                amount(base * 0.6),
+		Goodwill:                              amount(base * 0.4),
+		IntangibleAssets:                      amount(base * 0.55),
+		TotalLiabilities:                      amount(base * 2),
+		TotalCurrentLiabilities:               amount(base * 0.8),
+		CurrentAccountsPayable:                amount(base * 0.2),
+		ShortTermDebt:                         amount(base * 0.1),
+		LongTermDebt:                          amount(base * 0.8),
+		TotalNonCurrentLiabilities:            amount(base * 1.2),
+		TotalShareholderEquity:                amount(base),
+		RetainedEarnings:                      amount(base * 0.7),
+		CommonStock:                           amount(base * 0.3),
+		CommonStockSharesOutstanding:          amount(base / 150),
+	}
+	fillNone(&r)
+	return r
+}
+
+func balanceSheet(q url.Values) (interface{}, string) {
+	symbol, errMessage := requireSymbol(q)
+	if errMessage != "" {
+		return nil, errMessage
+	}
+	base := scale(symbol)
+
+	resp := alphavintage.BalanceSheetResponse{Symbol: symbol}
+	for i, date := range fiscalYears(5) {
+		resp.AnnualReports = append(resp.AnnualReports, balanceSheetReport(base*math.Pow(0.93, float64(i)), date))
+	}
+	for i, date := range fiscalQuarters(8) {
+		resp.QuarterlyReports = append(resp.QuarterlyReports, balanceSheetReport(base*math.Pow(0.98, float64(i)), date))
+	}
+	return resp, ""
+}
+
+func incomeStatementReport(base float64, date string) alphavintage.IncomeStatementReport {
+	r := alphavintage.IncomeStatementReport{
+		FiscalDateEnding:                  date,
+		ReportedCurrency:                  "USD",
+		TotalRevenue:                      amount(base * 0.6),
+		CostOfRevenue:                     amount(base * 0.25),
+		CostOfGoodsAndServicesSold:        amount(base * 0.25),
+		GrossProfit:                       amount(base * 0.35),
+		SellingGeneralAndAdministrative:   amount(base * 0.11),
+		ResearchAndDevelopment:            amount(base * 0.06),
+		OperatingExpenses:                 amount(base * 0.17),
+		OperatingIncome:                   amount(base * 0.18),
+		InterestIncome:                    amount(base * 0.005),
+		InterestExpense:                   amount(base * 0.01),
+		NetInterestIncome:                 amount(-base * 0.005),
+		InterestAndDebtExpense:            amount(base * 0.01),
+		Depreciation:                      amount(base * 0.03),
+		DepreciationAndAmortization:       amount(base * 0.04),
+		IncomeBeforeTax:                   amount(base * 0.185),
+		IncomeTaxExpense:                  amount(base * 0.035),
+		NetIncomeFromContinuingOperations: amount(base * 0.15),
+		ComprehensiveIncomeNetOfTax:       amount(base * 0.15),
+		EBIT:                              amount(base * 0.195),
+		EBITDA:                            amount(base * 0.235),
+		NetIncome:                         amount(base * 0.15),
+	}
+	fillNone(&r)
+	return r
+}
+
+func incomeStatement(q url.Values) (interface{}, string) {
+	symbol, errMessage := requireSymbol(q)
+	if errMessage != "" {
+		return nil, errMessage
+	}
+	base := scale(symbol)
+
+	resp := alphavintage.IncomeStatementResponse{Symbol: symbol}
+	for i, date := range fiscalYears(5) {
+		resp.AnnualReports = append(resp.AnnualReports, incomeStatementReport(base*math.Pow(0.93, float64(i)), date))
+	}
+	for i, date := range fiscalQuarters(8) {
+		resp.QuarterlyReports = append(resp.QuarterlyReports, incomeStatementReport(base/4*math.Pow(0.98, float64(i)), date))
+	}
+	return resp, ""
+}
+
+func cashFlowReport(base float64, date string) alphavintage.CashFlowReport {
+	r := alphavintage.CashFlowReport{
+		FiscalDateEnding:                     date,
+		ReportedCurrency:                     "USD",
+		OperatingCashflow:                    amount(base * 0.25),
+		DepreciationDepletionAndAmortization: amount(base * 0.04),
+		CapitalExpenditures:                  amount(base * 0.05),
+		ChangeInReceivables:                  amount(-base * 0.01),
+		ChangeInInventory:                    amount(-base * 0.005),
+		ProfitLoss:                           amount(base * 0.15),
+		CashflowFromInvestment:               amount(-base * 0.1),
+		CashflowFromFinancing:                amount(-base * 0.12),
+		PaymentsForRepurchaseOfCommonStock:   amount(base * 0.06),
+		DividendPayout:                       amount(base * 0.05),
+		DividendPayoutCommonStock:            amount(base * 0.05),
+		ChangeInCashAndCashEquivalents:       amount(base * 0.03),
+		NetIncome:                            amount(base * 0.15),
+	}
+	fillNone(&r)
+	return r
+}
+
+func cashFlow(q url.Values) (interface{}, string) {
+	symbol, errMessage := requireSymbol(q)
+	if errMessage != "" {
+		return nil, errMessage
+	}
+	base := scale(symbol)
+
+	resp := alphavintage.CashFlowResponse{Symbol: symbol}
+	for i, date := range fiscalYears(5) {
+		resp.AnnualReports = append(resp.AnnualReports, cashFlowReport(base*math.Pow(0.93, float64(i)), date))
+	}
+	for i, date := range fiscalQuarters(8) {
+		resp.QuarterlyReports = append(resp.QuarterlyReports, cashFlowReport(base/4*math.Pow(0.98, float64(i)), date))
+	}
+	return resp, ""
+}
+
+func earnings(q url.Values) (interface{}, string) {
+	symbol, errMessage := requireSymbol(q)
+	if errMessage != "" {
+		return nil, errMessage
+	}
+	rnd := seeded(symbol)
+	eps := 2 + rnd.Float64()*8
+
+	resp := alphavintage.EarningsResponse{Symbol: symbol}
+	for i, date := range fiscalYears(10) {
+		resp.AnnualEarnings = append(resp.AnnualEarnings, alphavintage.AnnualEarning{
+			FiscalDateEnding: date,
+			ReportedEPS:      fmt.Sprintf("%.2f", eps*math.Pow(0.92, float64(i))),
+		})
+	}
+	for i, date := range fiscalQuarters(12) {
+		estimated := eps / 4 * math.Pow(0.98, float64(i))
+		reported := estimated * (1 + rnd.NormFloat64()*0.05)
+		fiscalEnd, _ := time.Parse("2006-01-02", date)
+		resp.QuarterlyEarnings = append(resp.QuarterlyEarnings, alphavintage.QuarterlyEarning{
+			FiscalDateEnding:   date,
+			ReportedDate:       fiscalEnd.AddDate(0, 0, 28).Format("2006-01-02"),
+			ReportedEPS:        fmt.Sprintf("%.2f", reported),
+			EstimatedEPS:       fmt.Sprintf("%.2f", estimated),
+			Surprise:           fmt.Sprintf("%.2f", reported-estimated),
+			SurprisePercentage: fmt.Sprintf("%.4f", (reported-estimated)/estimated*100),
+		})
+	}
+	return resp, ""
+}
+
+func newsSentiment(q url.Values) (interface{}, string) {
+	tickers := strings.Split(strings.ToUpper(q.Get("tickers")), ",")
+	if tickers[0] == "" {
+		tickers = []string{"IBM"}
+	}
+
+	type story struct {
+		title, source, label string
+		score                float64
+	}
+	stories := []story{
+		{"%s beats quarterly expectations on strong cloud demand", "Reuters", "Bullish", 0.41},
+		{"Analysts weigh %s guidance after earnings call", "Bloomberg", "Neutral", 0.08},
+		{"%s faces margin pressure as costs rise", "MarketWatch", "Somewhat-Bearish", -0.22},
+		{"Why %s shares are moving today", "Motley Fool", "Somewhat-Bullish", 0.19},
+		{"%s announces new buyback program", "Benzinga", "Bullish", 0.37},
+	}
+
+	limit := len(stories)
+	fmt.Sscanf(q.Get("limit"), "%d", &limit)
+	if limit > len(stories) {
+		limit = len(stories)
+	}
+
+	resp := alphavintage.NewsSentimentResponse{
+		Items:                    fmt.Sprintf("%d", limit),
+		SentimentScoreDefinition: "x <= -0.35: Bearish; -0.35 < x <= -0.15: Somewhat-Bearish; -0.15 < x < 0.15: Neutral; 0.15 <= x < 0.35: Somewhat_Bullish; x >= 0.35: Bullish",
+		RelevanceScoreDefinition: "0 < x <= 1, with a higher score indicating higher relevance.",
+	}
+	for i := 0; i < limit; i++ {
+		s := stories[i]
+		ticker := tickers[i%len(tickers)]
+		resp.Feed = append(resp.Feed, alphavintage.NewsFeedItem{
+			Title:                 fmt.Sprintf(s.title, ticker),
+			URL:                   fmt.Sprintf("https://news.example.com/%s/%d", strings.ToLower(ticker), i),
+			TimePublished:         fmt.Sprintf("%sT%02d0000", strings.ReplaceAll(AsOf, "-", ""), 9+i),
+			Authors:               []string{"Staff Writer"},
+			Summary:               fmt.Sprintf(s.title, ticker) + ".",
+			Source:                s.source,
+			CategoryWithinSource:  "Business",
+			SourceDomain:          "news.example.com",
+			Topics:                []alphavintage.Topic{{Topic: "Earnings", RelevanceScore: "0.9"}},
+			OverallSentimentScore: s.score,
+			OverallSentimentLabel: s.label,
+			TickerSentiment: []alphavintage.TickerSentiment{{
+				Ticker:               ticker,
+				RelevanceScore:       "0.85",
+				TickerSentimentScore: fmt.Sprintf("%.6f", s.score),
+				TickerSentimentLabel: s.label,
+			}},
+		})
+	}
+	return resp, ""
+}
+
+// listed is the fake exchange listing behind SYMBOL_SEARCH and LISTING_STATUS
+var listed = []struct {
+	symbol, name, exchange, assetType, ipoDate, delistingDate string
+}{
+	{"AAPL", "Apple Inc", "NASDAQ", "Stock", "1980-12-12", ""},
+	{"AMZN", "Amazon.com Inc", "NASDAQ", "Stock", "1997-05-15", ""},
+	{"GOOGL", "Alphabet Inc - Class A", "NASDAQ", "Stock", "2004-08-19", ""},
+	{"IBM", "International Business Machines Corp", "NYSE", "Stock", "1962-01-02", ""},
+	{"MSFT", "Microsoft Corporation", "NASDAQ", "Stock", "1986-03-13", ""},
+	{"NVDA", "NVIDIA Corp", "NASDAQ", "Stock", "1999-01-22", ""},
+	{"SPY", "SPDR S&P 500 ETF Trust", "NYSE ARCA", "ETF", "1993-01-29", ""},
+	{"TSLA", "Tesla Inc", "NASDAQ", "Stock", "2010-06-29", ""},
+	{"ATVI", "Activision Blizzard Inc", "NASDAQ", "Stock", "1993-10-25", "2023-10-13"},
+	{"SIVB", "SVB Financial Group", "NASDAQ", "Stock", "1987-03-26", "2023-03-28"},
+	{"TWTR", "Twitter Inc", "NYSE", "Stock", "2013-11-07", "2022-11-08"},
+}
+
+func symbolSearch(q url.Values) (interface{}, string) {
+	keywords := strings.ToUpper(strings.TrimSpace(q.Get("keywords")))
+	if keywords == "" {
+		return nil, invalidCall("SYMBOL_SEARCH")
+	}
+
+	var matches []alphavintage.SymbolMatch
+	for _, l := range listed {
+		var score float64
+		switch {
+		case l.symbol == keywords:
+			score = 1
+		case strings.HasPrefix(l.symbol, keywords):
+			score = float64(len(keywords)) / float64(len(l.symbol))
+		case strings.Contains(strings.ToUpper(l.name), keywords):
+			score = float64(len(keywords)) / float64(len(l.name))
+		default:
+			continue
+		}
+		kind := l.assetType
+		if kind == "Stock" {
+			kind = "Equity"
+		}
+		matches = append(matches, alphavintage.SymbolMatch{
+			Symbol:      l.symbol,
+			Name:        l.name,
+			Type:        kind,
+			Region:      "United States",
+			MarketOpen:  "09:30",
+			MarketClose: "16:00",
+			Timezone:    "UTC-04",
+			Currency:    "USD",
+			MatchScore:  fmt.Sprintf("%.4f", score),
+		})
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].MatchScore > matches[j].MatchScore
+	})
+	if matches == nil {
+		matches = []alphavintage.SymbolMatch{}
+	}
+	return alphavintage.SymbolSearchResponse{BestMatches: matches}, ""
+}
+
+func listingStatus(q url.Values) (interface{}, string) {
+	state := q.Get("state")
+	if state == "" {
+		state = "active"
+	}
+	if state != "active" && state != "delisted" {
+		return nil, invalidCall("LISTING_STATUS")
+	}
+	date := q.Get("date")
+	if date == "" {
+		date = AsOf
+	}
+
+	var sb strings.Builder
+	sb.WriteString("symbol,name,exchange,assetType,ipoDate,delistingDate,status\r\n")
+	for _, l := range listed {
+		if l.ipoDate > date {
+			continue
+		}
+		delisted := l.delistingDate != "" && l.delistingDate <= date
+		if delisted != (state == "delisted") {
+			continue
+		}
+		delistingDate, status := "null", "Active"
+		if delisted {
+			delistingDate, status = l.delistingDate, "Delisted"
+		}
+		fmt.Fprintf(&sb, "%s,%s,%s,%s,%s,%s,%s\r\n", l.symbol, l.name, l.exchange, l.assetType, l.ipoDate, delistingDate, status)
+	}
+	return csvPayload(sb.String()), ""
+}
+
+func earningsCalendar(q url.Values) (interface{}, string) {
+	months := map[string]int{"": 3, "3month": 3, "6month": 6, "12month": 12}[q.Get("horizon")]
+	if months == 0 {
+		return nil, invalidCall("EARNINGS_CALENDAR")
+	}
+	symbol := strings.ToUpper(q.Get("symbol"))
+	asOf, _ := time.Parse("2006-01-02", AsOf)
+	until := asOf.AddDate(0, months, 0)
+
+	var sb strings.Builder
+	sb.WriteString("symbol,name,reportDate,fiscalDateEnding,estimate,currency\r\n")
+	for _, l := range listed {
+		if l.assetType != "Stock" || l.delistingDate != "" || (symbol != "" && l.symbol != symbol) {
+			continue
+		}
+		// Each company reports every 13 weeks, starting within the next 13
+		rnd := seeded(l.symbol)
+		report := asOf.AddDate(0, 0, 1+rnd.Intn(91))
+		last, _ := lastQuote(l.symbol)
+		estimate := last.close / 16 / 4 * (0.9 + rnd.Float64()*0.2)
+		if report.Weekday() == time.Saturday || report.Weekday() == time.Sunday {
+			report = report.AddDate(0, 0, 2)
+		}
+		for ; !report.After(until); report = report.AddDate(0, 0, 91) {
+			quarterEnd := time.Date(report.Year(), report.Month()-(report.Month()-1)%3, 0, 0, 0, 0, 0, time.UTC)
+			fmt.Fprintf(&sb, "%s,%s,%s,%s,%.2f,USD\r\n", l.symbol, l.name,
+				report.Format("2006-01-02"), quarterEnd.Format("2006-01-02"), estimate)
+		}
+	}
+	return csvPayload(sb.String()), ""
+}
+
+func ipoCalendar(q url.Values) (interface{}, string) {
+	return csvPayload("symbol,name,ipoDate,priceRangeLow,priceRangeHigh,currency,exchange\r\n" +
+		"NEWCO,Newco Holdings Inc,2025-01-08,14.00,16.00,USD,NASDAQ\r\n" +
+		"ORBT,Orbit Robotics Corp,2025-01-15,21.00,24.00,USD,NYSE\r\n" +
+		"SPAQU,Spark Acquisition Corp Units,2025-01-22,0,0,USD,NASDAQ\r\n"), ""
+}
+
+// perUSD is how many units of each currency a dollar buys on AsOf
+var perUSD = map[string]float64{
+	"USD": 1,
+	"EUR": 0.9592,
+	"GBP": 0.7986,
+	"JPY": 156.42,
+	"CHF": 0.8935,
+	"CAD": 1.4365,
+	"AUD": 1.6021,
+	"CNY": 7.2978,
+	"BTC": 1.0 / 97421.5,
+	"ETH": 1.0 / 3482.1,
+}
+
+var currencyNames = map[string]string{
+	"USD": "United States Dollar",
+	"EUR": "Euro",
+	"GBP": "British Pound Sterling",
+	"JPY": "Japanese Yen",
+	"CHF": "Swiss Franc",
+	"CAD": "Canadian Dollar",
+	"AUD": "Australian Dollar",
+	"CNY": "Chinese Yuan",
+	"BTC": "Bitcoin",
+	"ETH": "Ethereum",
+}
+
+// fxRate returns the AsOf rate between two known currencies
+func fxRate(from, to string) (float64, bool) {
+	f, okFrom := perUSD[from]
+	t, okTo := perUSD[to]
+	return t / f, okFrom && okTo
+}
+
+// fxBars returns a daily walk of a currency pair that ends at its AsOf rate
+func fxBars(from, to string, rate float64) ([]time.Time, []bar) {
+	bars := priceWalk(from+to, 1000, 0.004)
+	k := rate / bars[len(bars)-1].close
+	for i := range bars {
+		bars[i].open *= k
+		bars[i].high *= k
+		bars[i].low *= k
+		bars[i].close *= k
+	}
+	return tradingDays(1000), bars
+}
+
+func ohlc(b bar) map[string]string {
+	return map[string]string{
+		"1. open":  fmt.Sprintf("%.5f", b.open),
+		"2. high":  fmt.Sprintf("%.5f", b.high),
+		"3. low":   fmt.Sprintf("%.5f", b.low),
+		"4. close": fmt.Sprintf("%.5f", b.close),
+	}
+}
+
+func exchangeRate(q url.Values) (interface{}, string) {
+	from, to := strings.ToUpper(q.Get("from_currency")), strings.ToUpper(q.Get("to_currency"))
+	rate, ok := fxRate(from, to)
+	if !ok {
+		return nil, invalidCall("CURRENCY_EXCHANGE_RATE")
+	}
+	return alphavintage.CurrencyExchangeRateResponse{Rate: alphavintage.ExchangeRate{
+		FromCurrencyCode: from,
+		FromCurrencyName: currencyNames[from],
+		ToCurrencyCode:   to,
+		ToCurrencyName:   currencyNames[to],
+		ExchangeRate:     fmt.Sprintf("%.8f", rate),
+		LastRefreshed:    AsOf + " 21:59:01",
+		TimeZone:         "UTC",
+		BidPrice:         fmt.Sprintf("%.8f", rate*0.9999),
+		AskPrice:         fmt.Sprintf("%.8f", rate*1.0001),
+	}}, ""
+}
+
+func fxSeries(period string) handler {
+	return func(q url.Values) (interface{}, string) {
+		from, to := strings.ToUpper(q.Get("from_symbol")), strings.ToUpper(q.Get("to_symbol"))
+		rate, ok := fxRate(from, to)
+		if !ok {
+			return nil, invalidCall(q.Get("function"))
+		}
+		days, bars := fxBars(from, to, rate)
+
+		meta := map[string]string{
+			"1. Information": "Forex " + period + " Prices (open, high, low, close)",
+			"2. From Symbol": from,
+			"3. To Symbol":   to,
+		}
+		series := make(map[string]interface{})
+		if period == "Daily" {
+			n, size := outputSize(q, 100, len(days))
+			for i := len(days) - n; i < len(days); i++ {
+				series[days[i].Format("2006-01-02")] = ohlc(bars[i])
+			}
+			meta["4. Output Size"] = size
+			meta["5. Last Refreshed"] = AsOf
+			meta["6. Time Zone"] = "UTC"
+		} else {
+			periodOf := func(t time.Time) string {
+				if period == "Weekly" {
+					year, week := t.ISOWeek()
+					return fmt.Sprintf("%d-%02d", year, week)
+				}
+				return t.Format("2006-01")
+			}
+			for start := 0; start < len(days); {
+				end := start
+				for end+1 < len(days) && periodOf(days[end+1]) == periodOf(days[start]) {
+					end++
+				}
+				agg := bar{open: bars[start].open, high: bars[start].high, low: bars[start].low, close: bars[end].close}
+				for i := start; i <= end; i++ {
+					agg.high = math.Max(agg.high, bars[i].high)
+					agg.low = math.Min(agg.low, bars[i].low)
+				}
+				series[days[end].Format("2006-01-02")] = ohlc(agg)
+				start = end + 1
+			}
+			meta["4. Last Refreshed"] = AsOf
+			meta["5. Time Zone"] = "UTC"
+		}
+
+		return map[string]interface{}{
+			"Meta Data":                       meta,
+			"Time Series FX (" + period + ")": series,
+		}, ""
+	}
+}
+
+func fxIntraday(q url.Values) (interface{}, string) {
+	from, to := strings.ToUpper(q.Get("from_symbol")), strings.ToUpper(q.Get("to_symbol"))
+	rate, ok := fxRate(from, to)
+	interval := q.Get("interval")
+	minutes, okInterval := intervalMinutes[interval]
+	if !ok || !okInterval {
+		return nil, invalidCall("FX_INTRADAY")
+	}
+
+	// FX trades around the clock, so the bars run back from the end of AsOf
+	n, size := outputSize(q, 100, 2000)
+	end, _ := time.Parse("2006-01-02", AsOf)
+	end = end.Add(24*time.Hour - time.Duration(minutes)*time.Minute)
+	bars := priceWalk(from+to+interval, n, 0.0005)
+	k := rate / bars[n-1].close
+
+	series := make(map[string]interface{}, n)
+	for i, b := range bars {
+		b.open, b.high, b.low, b.close = b.open*k, b.high*k, b.low*k, b.close*k
+		stamp := end.Add(-time.Duration((n-1-i)*minutes) * time.Minute)
+		series[stamp.Format("2006-01-02 15:04:05")] = ohlc(b)
+	}
+
+	return map[string]interface{}{
+		"Meta Data": map[string]string{
+			"1. Information":    "FX Intraday (" + interval + ") Time Series",
+			"2. From Symbol":    from,
+			"3. To Symbol":      to,
+			"4. Last Refreshed": end.Format("2006-01-02 15:04:05"),
+			"5. Interval":       interval,
+			"6. Output Size":    size,
+			"7. Time Zone":      "UTC",
+		},
+		"Time Series FX (" + interval + ")": series,
+	}, ""
+}
+
+var digitalCurrencies = map[string]bool{"BTC": true, "ETH": true}
+
+// calendarDays returns the n days ending at AsOf, weekends included, oldest first
+func calendarDays(n int) []time.Time {
+	last, _ := time.Parse("2006-01-02", AsOf)
+	days := make([]time.Time, n)
+	for i := range days {
+		days[i] = last.AddDate(0, 0, i-n+1)
+	}
+	return days
+}
+
+// cryptoBars returns a walk of a digital currency priced in market that
+// ends at its AsOf rate, with fractional coin volumes
+func cryptoBars(key string, n int, volatility, rate float64) []bar {
+	bars := priceWalk(key, n, volatility)
+	k := rate / bars[n-1].close
+	for i := range bars {
+		bars[i].open *= k
+		bars[i].high *= k
+		bars[i].low *= k
+		bars[i].close *= k
+	}
+	return bars
+}
+
+func cryptoOHLCV(b bar) map[string]string {
+	return map[string]string{
+		"1. open":   fmt.Sprintf("%.8f", b.open),
+		"2. high":   fmt.Sprintf("%.8f", b.high),
+		"3. low":    fmt.Sprintf("%.8f", b.low),
+		"4. close":  fmt.Sprintf("%.8f", b.close),
+		"5. volume": fmt.Sprintf("%.8f", float64(b.volume)/1e4),
+	}
+}
+
+func cryptoPair(q url.Values) (symbol, market string, rate float64, ok bool) {
+	symbol, market = strings.ToUpper(q.Get("symbol")), strings.ToUpper(q.Get("market"))
+	if !digitalCurrencies[symbol] {
+		return symbol, market, 0, false
+	}
+	rate, ok = fxRate(symbol, market)
+	return symbol, market, rate, ok
+}
+
+func digitalCurrency(period string) handler {
+	return func(q url.Values) (interface{}, string) {
+		symbol, market, rate, ok := cryptoPair(q)
+		if !ok {
+			return nil, invalidCall(q.Get("function"))
+		}
+		days := calendarDays(1000)
+		bars := cryptoBars(symbol+market, len(days), 0.03, rate)
+
+		// Weeks end on Sunday, months on their last day
+		periodOf := func(t time.Time) string {
+			switch period {
+			case "Weekly":
+				return t.AddDate(0, 0, -int(t.Weekday()+6)%7).Format("2006-01-02")
+			case "Monthly":
+				return t.Format("2006-01")
+			}
+			return t.Format("2006-01-02")
+		}
+		series := make(map[string]interface{})
+		for start := 0; start < len(days); {
+			end := start
+			for end+1 < len(days) && periodOf(days[end+1]) == periodOf(days[start]) {
+				end++
+			}
+			agg := bar{open: bars[start].open, high: bars[start].high, low: bars[start].low, close: bars[end].close}
+			for i := start; i <= end; i++ {
+				agg.high = math.Max(agg.high, bars[i].high)
+				agg.low = math.Min(agg.low, bars[i].low)
+				agg.volume += bars[i].volume
+			}
+			series[days[end].Format("2006-01-02")] = cryptoOHLCV(agg)
+			start = end + 1
+		}
+
+		return map[string]interface{}{
+			"Meta Data": map[string]string{
+				"1. Information":           period + " Prices and Volumes for Digital Currency",
+				"2. Digital Currency Code": symbol,
+				"3. Digital Currency Name": currencyNames[symbol],
+				"4. Market Code":           market,
+				"5. Market Name":           currencyNames[market],
+				"6. Last Refreshed":        AsOf + " 00:00:00",
+				"7. Time Zone":             "UTC",
+			},
+			"Time Series (Digital Currency " + period + ")": series,
+		}, ""
+	}
+}
+
+func cryptoIntraday(q url.Values) (interface{}, string) {
+	symbol, market, rate, ok := cryptoPair(q)
+	interval := q.Get("interval")
+	minutes, okInterval := intervalMinutes[interval]
+	if !ok || !okInterval {
+		return nil, invalidCall("CRYPTO_INTRADAY")
+	}
+
+	n, size := outputSize(q, 100, 2000)
+	end, _ := time.Parse("2006-01-02", AsOf)
+	end = end.Add(24*time.Hour - time.Duration(minutes)*time.Minute)
+	bars := cryptoBars(symbol+market+interval, n, 0.002, rate)
+
+	series := make(map[string]interface{}, n)
+	for i, b := range bars {
+		b.volume /= 100
+		stamp := end.Add(-time.Duration((n-1-i)*minutes) * time.Minute)
+		series[stamp.Format("2006-01-02 15:04:05")] = cryptoOHLCV(b)
+	}
+
+	return map[string]interface{}{
+		"Meta Data": map[string]string{
+			"1. Information":           "Crypto Intraday (" + interval + ") Time Series",
+			"2. Digital Currency Code": symbol,
+			"3. Digital Currency Name": currencyNames[symbol],
+			"4. Market Code":           market,
+			"5. Market Name":           currencyNames[market],
+			"6. Last Refreshed":        end.Format("2006-01-02 15:04:05"),
+			"7. Interval":              interval,
+			"8. Output Size":           size,
+			"9. Time Zone":             "UTC",
+		},
+		"Time Series Crypto (" + interval + ")": series,
+	}, ""
+}
+
+// observationDates returns n dates of an economic series, newest first.
+// Monthly and slower series are dated on the first day of their period
+func observationDates(interval string, n int) []time.Time {
+	asOf, _ := time.Parse("2006-01-02", AsOf)
+	if interval == "daily" {
+		days := tradingDays(n)
+		for i, j := 0, len(days)-1; i < j; i, j = i+1, j-1 {
+			days[i], days[j] = days[j], days[i]
+		}
+		return days
+	}
+
+	dates := make([]time.Time, n)
+	first := time.Date(asOf.Year(), asOf.Month()-1, 1, 0, 0, 0, 0, time.UTC)
+	for i := range dates {
+		switch interval {
+		case "weekly":
+			// Weeks ending Friday
+			dates[i] = asOf.AddDate(0, 0, -int(asOf.Weekday()+2)%7-7*i)
+		case "quarterly":
+			dates[i] = time.Date(first.Year(), first.Month()-(first.Month()-1)%3-time.Month(3*i), 1, 0, 0, 0, 0, time.UTC)
+		case "semiannual":
+			dates[i] = time.Date(first.Year(), first.Month()-(first.Month()-1)%6-time.Month(6*i), 1, 0, 0, 0, 0, time.UTC)
+		case "annual":
+			dates[i] = time.Date(asOf.Year()-1-i, 1, 1, 0, 0, 0, 0, time.UTC)
+		default:
+			dates[i] = first.AddDate(0, -i, 0)
+		}
+	}
+	return dates
+}
+
+// economic serves an indicator or commodity whose latest value is near
+// level. The first interval is the default
+func economic(name, unit string, level, volatility float64, intervals ...string) handler {
+	return func(q url.Values) (interface{}, string) {
+		interval := q.Get("interval")
+		if interval == "" {
+			interval = intervals[0]
+		}
+		supported := false
+		for _, i := range intervals {
+			supported = supported || i == interval
+		}
+		if !supported {
+			return nil, invalidCall(q.Get("function"))
+		}
+		return economicSeries(q.Get("function")+interval, name, unit, interval, level, volatility), ""
+	}
+}
+
+func economicSeries(key, name, unit, interval string, level, volatility float64) alphavintage.EconomicSeries {
+	n := map[string]int{"daily": 1000, "weekly": 520}[interval]
+	if n == 0 {
+		n = 120
+	}
+	dates := observationDates(interval, n)
+	walk := priceWalk(key, n, volatility)
+	k := level / walk[n-1].close
+
+	series := alphavintage.EconomicSeries{Name: name, Interval: interval, Unit: unit}
+	for i, date := range dates {
+		// Holidays have no observation, like on the real API
+		value := "."
+		if interval != "daily" || i%50 != 7 {
+			value = fmt.Sprintf("%.2f", walk[n-1-i].close*k)
+		}
+		series.Data = append(series.Data, alphavintage.EconomicDataPoint{Date: date.Format("2006-01-02"), Value: value})
+	}
+	return series
+}
+
+var maturities = map[string]float64{
+	"3month": 4.32, "2year": 4.31, "5year": 4.37, "7year": 4.45, "10year": 4.52, "30year": 4.72,
+}
+
+func treasuryYield(q url.Values) (interface{}, string) {
+	maturity := q.Get("maturity")
+	if maturity == "" {
+		maturity = "10year"
+	}
+	interval := q.Get("interval")
+	if interval == "" {
+		interval = "monthly"
+	}
+	level, ok := maturities[maturity]
+	if !ok || (interval != "daily" && interval != "weekly" && interval != "monthly") {
+		return nil, invalidCall("TREASURY_YIELD")
+	}
+	name := "Market Yield on U.S. Treasury Securities at " + strings.TrimSuffix(strings.TrimSuffix(maturity, "year"), "month")
+	if strings.HasSuffix(maturity, "month") {
+		name += "-Month"
+	} else {
+		name += "-Year"
+	}
+	return economicSeries("TREASURY_YIELD"+maturity+interval, name+" Constant Maturity, Quoted on an Investment Basis",
+		"percent", interval, level, 0.02), ""
+}
+
+// indicatorFunc computes indicator columns over daily bars. A column value
+// is NaN until enough bars have been seen
+type indicatorFunc func(bars []bar, prices []float64, period int) map[string][]float64
+
+// indicator serves a technical indicator computed from the daily walk, so
+// it lines up with TIME_SERIES_DAILY. Only the daily interval is served
+func indicator(name string, needsPeriod, needsSeries bool, compute indicatorFunc) handler {
+	return func(q url.Values) (interface{}, string) {
+		symbol, errMessage := requireSymbol(q)
+		if errMessage != "" {
+			return nil, errMessage
+		}
+		function := q.Get("function")
+		period := 0
+		fmt.Sscanf(q.Get("time_period"), "%d", &period)
+		seriesType := q.Get("series_type")
+		if q.Get("interval") != "daily" || (needsPeriod && period <= 0) ||
+			(needsSeries && seriesType != "close" && seriesType != "open" && seriesType != "high" && seriesType != "low") {
+			return nil, invalidCall(function)
+		}
+
+		days, bars := dailyBars(symbol)
+		prices := make([]float64, len(bars))
+		for i, b := range bars {
+			prices[i] = map[string]float64{"open": b.open, "high": b.high, "low": b.low, "close": b.close}[seriesType]
+			if prices[i] == 0 {
+				prices[i] = b.close
+			}
+		}
+		columns := compute(bars, prices, period)
+
+		series := make(map[string]map[string]string)
+		for i, day := range days {
+			point := make(map[string]string)
+			for column, values := range columns {
+				if !math.IsNaN(values[i]) {
+					point[column] = fmt.Sprintf("%.4f", values[i])
+				}
+			}
+			if len(point) > 0 {
+				series[day.Format("2006-01-02")] = point
+			}
+		}
+
+		meta := map[string]interface{}{
+			"1: Symbol":         symbol,
+			"2: Indicator":      name,
+			"3: Last Refreshed": AsOf,
+			"4: Interval":       "daily",
+		}
+		n := 5
+		if needsPeriod {
+			meta[fmt.Sprintf("%d: Time Period", n)] = period
+			n++
+		}
+		if needsSeries {
+			meta[fmt.Sprintf("%d: Series Type", n)] = seriesType
+			n++
+		}
+		meta[fmt.Sprintf("%d: Time Zone", n)] = "US/Eastern Time"
+
+		return map[string]interface{}{
+			"Meta Data":                       meta,
+			"Technical Analysis: " + function: series,
+		}, ""
+	}
+}
+
+func nans(n int) []float64 {
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = math.NaN()
+	}
+	return values
+}
+
+func smaOf(prices []float64, period int) []float64 {
+	values := nans(len(prices))
+	sum := 0.0
+	for i, p := range prices {
+		sum += p
+		if i >= period {
+			sum -= prices[i-period]
+		}
+		if i >= period-1 {
+			values[i] = sum / float64(period)
+		}
+	}
+	return values
+}
+
+func emaOf(prices []float64, period int) []float64 {
+	values := smaOf(prices, period)
+	k := 2 / float64(period+1)
+	for i := period; i < len(prices); i++ {
+		values[i] = prices[i]*k + values[i-1]*(1-k)
+	}
+	return values
+}
+
+func sma(_ []bar, prices []float64, period int) map[string][]float64 {
+	return map[string][]float64{"SMA": smaOf(prices, period)}
+}
+
+func ema(_ []bar, prices []float64, period int) map[string][]float64 {
+	return map[string][]float64{"EMA": emaOf(prices, period)}
+}
+
+func rsi(_ []bar, prices []float64, period int) map[string][]float64 {
+	values := nans(len(prices))
+	var gain, loss float64
+	for i := 1; i < len(prices); i++ {
+		change := prices[i] - prices[i-1]
+		up, down := math.Max(change, 0), math.Max(-change, 0)
+		if i <= period {
+			gain += up / float64(period)
+			loss += down / float64(period)
+		} else {
+			gain = (gain*float64(period-1) + up) / float64(period)
+			loss = (loss*float64(period-1) + down) / float64(period)
+		}
+		if i >= period {
+			values[i] = 100
+			if loss > 0 {
+				values[i] = 100 - 100/(1+gain/loss)
+			}
+		}
+	}
+	return map[string][]float64{"RSI": values}
+}
+
+func bbands(_ []bar, prices []float64, period int) map[string][]float64 {
+	middle := smaOf(prices, period)
+	upper, lower := nans(len(prices)), nans(len(prices))
+	for i := period - 1; i < len(prices); i++ {
+		variance := 0.0
+		for _, p := range prices[i-period+1 : i+1] {
+			variance += (p - middle[i]) * (p - middle[i])
+		}
+		sd := math.Sqrt(variance / float64(period))
+		upper[i], lower[i] = middle[i]+2*sd, middle[i]-2*sd
+	}
+	return map[string][]float64{"Real Upper Band": upper, "Real Middle Band": middle, "Real Lower Band": lower}
+}
+
+func macd(_ []bar, prices []float64, _ int) map[string][]float64 {
+	fast, slow := emaOf(prices, 12), emaOf(prices, 26)
+	line := nans(len(prices))
+	for i := range prices {
+		line[i] = fast[i] - slow[i]
+	}
+	signal := nans(len(prices))
+	k := 2 / float64(9+1)
+	for i := 25; i < len(prices); i++ {
+		switch {
+		case i < 33:
+		case i == 33:
+			sum := 0.0
+			for _, v := range line[25:34] {
+				sum += v
+			}
+			signal[i] = sum / 9
+		default:
+			signal[i] = line[i]*k + signal[i-1]*(1-k)
+		}
+	}
+	hist := nans(len(prices))
+	for i := range prices {
+		hist[i] = line[i] - signal[i]
+		if math.IsNaN(signal[i]) {
+			line[i] = math.NaN()
+		}
+	}
+	return map[string][]float64{"MACD": line, "MACD_Signal": signal, "MACD_Hist": hist}
+}
+
+func stoch(bars []bar, _ []float64, _ int) map[string][]float64 {
+	fastK := nans(len(bars))
+	for i := 4; i < len(bars); i++ {
+		high, low := bars[i].high, bars[i].low
+		for _, b := range bars[i-4 : i+1] {
+			high, low = math.Max(high, b.high), math.Min(low, b.low)
+		}
+		fastK[i] = 50
+		if high > low {
+			fastK[i] = (bars[i].close - low) / (high - low) * 100
+		}
+	}
+	slowK := nans(len(bars))
+	for i := 6; i < len(bars); i++ {
+		slowK[i] = (fastK[i] + fastK[i-1] + fastK[i-2]) / 3
+	}
+	slowD := nans(len(bars))
+	for i := 8; i < len(bars); i++ {
+		slowD[i] = (slowK[i] + slowK[i-1] + slowK[i-2]) / 3
+	}
+	return map[string][]float64{"SlowK": slowK, "SlowD": slowD}
+}
+
+func obv(bars []bar, _ []float64, _ int) map[string][]float64 {
+	values := make([]float64, len(bars))
+	for i := 1; i < len(bars); i++ {
+		values[i] = values[i-1]
+		switch {
+		case bars[i].close > bars[i-1].close:
+			values[i] += float64(bars[i].volume)
+		case bars[i].close < bars[i-1].close:
+			values[i] -= float64(bars[i].volume)
+		}
+	}
+	return map[string][]float64{"OBV": values}
+}
+
+func normCDF(x float64) float64 {
+	return 0.5 * (1 + math.Erf(x/math.Sqrt2))
+}
+
+func normPDF(x float64) float64 {
+	return math.Exp(-x*x/2) / math.Sqrt(2*math.Pi)
+}
+
+// optionQuote prices a contract with Black-Scholes at a 4.5% rate
+func optionQuote(symbol string, day, expiry time.Time, spot, strike float64, put, greeks bool) alphavintage.OptionQuote {
+	const rate = 0.045
+	years := math.Max(expiry.Sub(day).Hours()/24/365, 1.0/365)
+	// A mild smile around the money
+	iv := 0.22 + 0.3*math.Pow(math.Log(strike/spot), 2)/years*0.1
+	d1 := (math.Log(spot/strike) + (rate+iv*iv/2)*years) / (iv * math.Sqrt(years))
+	d2 := d1 - iv*math.Sqrt(years)
+	discount := math.Exp(-rate * years)
+
+	kind, price, delta, rho := "call", spot*normCDF(d1)-strike*discount*normCDF(d2), normCDF(d1), strike*years*discount*normCDF(d2)/100
+	theta := (-spot*normPDF(d1)*iv/(2*math.Sqrt(years)) - rate*strike*discount*normCDF(d2)) / 365
+	if put {
+		kind, price, delta, rho = "put", strike*discount*normCDF(-d2)-spot*normCDF(-d1), normCDF(d1)-1, -strike*years*discount*normCDF(-d2)/100
+		theta = (-spot*normPDF(d1)*iv/(2*math.Sqrt(years)) + rate*strike*discount*normCDF(-d2)) / 365
+	}
+	price = math.Max(price, 0.01)
+	spread := math.Max(0.01, price*0.02)
+
+	rnd := seeded(fmt.Sprintf("%s%s%.2f%s", symbol, expiry.Format("060102"), strike, kind))
+	q := alphavintage.OptionQuote{
+		ContractID:   fmt.Sprintf("%s%s%s%08d", symbol, expiry.Format("060102"), strings.ToUpper(kind[:1]), int(strike*1000)),
+		Symbol:       symbol,
+		Expiration:   expiry.Format("2006-01-02"),
+		Strike:       fmt.Sprintf("%.2f", strike),
+		Type:         kind,
+		Last:         fmt.Sprintf("%.2f", price*(0.98+rnd.Float64()*0.04)),
+		Mark:         fmt.Sprintf("%.2f", price),
+		Bid:          fmt.Sprintf("%.2f", price-spread/2),
+		BidSize:      fmt.Sprintf("%d", 1+rnd.Intn(200)),
+		Ask:          fmt.Sprintf("%.2f", price+spread/2),
+		AskSize:      fmt.Sprintf("%d", 1+rnd.Intn(200)),
+		Volume:       fmt.Sprintf("%d", int(2000*normPDF(d1))+rnd.Intn(50)),
+		OpenInterest: fmt.Sprintf("%d", int(8000*normPDF(d1))+rnd.Intn(500)),
+		Date:         day.Format("2006-01-02"),
+	}
+	if greeks {
+		q.ImpliedVolatility = fmt.Sprintf("%.5f", iv)
+		q.Delta = fmt.Sprintf("%.5f", delta)
+		q.Gamma = fmt.Sprintf("%.5f", normPDF(d1)/(spot*iv*math.Sqrt(years)))
+		q.Theta = fmt.Sprintf("%.5f", theta)
+		q.Vega = fmt.Sprintf("%.5f", spot*normPDF(d1)*math.Sqrt(years)/100)
+		q.Rho = fmt.Sprintf("%.5f", rho)
+	}
+	return q
+}
+
+// expirations returns the next four weekly expiries and the monthly
+// (third Friday) expiries of the following three months
+func expirations(day time.Time) []time.Time {
+	var dates []time.Time
+	friday := day.AddDate(0, 0, (int(time.Friday)-int(day.Weekday())+7)%7)
+	if !friday.After(day) {
+		friday = friday.AddDate(0, 0, 7)
+	}
+	for i := 0; i < 4; i++ {
+		dates = append(dates, friday.AddDate(0, 0, 7*i))
+	}
+	for m := 1; m <= 3; m++ {
+		first := time.Date(day.Year(), day.Month()+time.Month(m), 1, 0, 0, 0, 0, time.UTC)
+		third := first.AddDate(0, 0, (int(time.Friday)-int(first.Weekday())+7)%7+14)
+		if third.After(dates[len(dates)-1]) {
+			dates = append(dates, third)
+		}
+	}
+	return dates
+}
+
+func options(realtime bool) handler {
+	return func(q url.Values) (interface{}, string) {
+		symbol, errMessage := requireSymbol(q)
+		if errMessage != "" {
+			return nil, errMessage
+		}
+		days, bars := dailyBars(symbol)
+
+		// Historical chains exist for the days of the daily walk
+		i := len(days) - 1
+		if date := q.Get("date"); !realtime && date != "" {
+			i = sort.Search(len(days), func(i int) bool { return days[i].Format("2006-01-02") >= date })
+			if i == len(days) || days[i].Format("2006-01-02") != date {
+				return map[string]interface{}{"endpoint": "Historical Options", "message": "No data for symbol " + symbol + " on " + date, "data": []interface{}{}}, ""
+			}
+		}
+		day, spot := days[i], bars[i].close
+		greeks := !realtime || q.Get("require_greeks") == "true"
+
+		step := 5.0
+		switch {
+		case spot < 50:
+			step = 1
+		case spot < 200:
+			step = 2.5
+		}
+		center := math.Round(spot/step) * step
+
+		var data []alphavintage.OptionQuote
+		for _, expiry := range expirations(day) {
+			for k := -8; k <= 8; k++ {
+				strike := center + float64(k)*step
+				if strike <= 0 {
+					continue
+				}
+				data = append(data,
+					optionQuote(symbol, day, expiry, spot, strike, false, greeks),
+					optionQuote(symbol, day, expiry, spot, strike, true, greeks))
+			}
+		}
+
+		endpoint := "Historical Options"
+		if realtime {
+			endpoint = "Realtime Options"
+		}
+		return alphavintage.OptionsResponse{Endpoint: endpoint, Message: "success", Data: data}, ""
+	}
+}
+
+// dividendHistory lists the dividends adjustedBars pays, newest first
+func dividendHistory(q url.Values) (interface{}, string) {
+	symbol, errMessage := requireSymbol(q)
+	if errMessage != "" {
+		return nil, errMessage
+	}
+	days, bars := dailyBars(symbol)
+	dividends, _ := adjustedBars(bars)
+
+	resp := alphavintage.DividendsResponse{Symbol: symbol, Data: []alphavintage.DividendEvent{}}
+	for i := len(days) - 1; i >= 0; i-- {
+		if dividends[i] == 0 {
+			continue
+		}
+		exDate := days[i]
+		resp.Data = append(resp.Data, alphavintage.DividendEvent{
+			ExDividendDate:  exDate.Format("2006-01-02"),
+			DeclarationDate: exDate.AddDate(0, 0, -28).Format("2006-01-02"),
+			RecordDate:      exDate.Format("2006-01-02"),
+			PaymentDate:     exDate.AddDate(0, 0, 28).Format("2006-01-02"),
+			Amount:          fmt.Sprintf("%.2f", dividends[i]),
+		})
+	}
+	return resp, ""
+}
+
+// splitHistory is always empty, the daily walk has no splits
+func splitHistory(q url.Values) (interface{}, string) {
+	symbol, errMessage := requireSymbol(q)
+	if errMessage != "" {
+		return nil, errMessage
+	}
+	return alphavintage.SplitsResponse{Symbol: symbol, Data: []alphavintage.SplitEvent{}}, ""
+}
+
+var executives = [][2]string{
+	{"Jordan Avery", "Chief Executive Officer, Director"},
+	{"Casey Morgan", "Chief Financial Officer"},
+	{"Riley Chen", "General Counsel"},
+	{"Taylor Brooks", "Director"},
+	{"Alex Romero", "Director"},
+}
+
+// insiderTransactions returns awards, open-market trades and option
+// exercises over the last two years of the daily walk, newest first
+func insiderTransactions(q url.Values) (interface{}, string) {
+	symbol, errMessage := requireSymbol(q)
+	if errMessage != "" {
+		return nil, errMessage
+	}
+	r := seeded(symbol + "/insider")
+	days, bars := dailyBars(symbol)
+
+	resp := alphavintage.InsiderTransactionsResponse{Data: []alphavintage.InsiderTransaction{}}
+	for i := len(days) - 1; i >= len(days)-504; i -= 3 + r.Intn(10) {
+		exec := executives[r.Intn(len(executives))]
+		t := alphavintage.InsiderTransaction{
+			TransactionDate:       days[i].Format("2006-01-02"),
+			Ticker:                symbol,
+			Executive:             exec[0],
+			ExecutiveTitle:        exec[1],
+			SecurityType:          "Common Stock",
+			AcquisitionOrDisposal: "D",
+			Shares:                fmt.Sprintf("%.1f", float64(100*(1+r.Intn(200)))),
+			SharePrice:            money(bars[i].close),
+		}
+		switch roll := r.Float64(); {
+		case roll < 0.15:
+			t.AcquisitionOrDisposal = "A"
+			t.SharePrice = "0.0"
+		case roll < 0.35:
+			t.AcquisitionOrDisposal = "A"
+		case roll < 0.45:
+			t.SecurityType = "Employee Stock Option (Right to Buy)"
+			t.SharePrice = money(bars[i].close * 0.7)
+		}
+		resp.Data = append(resp.Data, t)
+	}
+	return resp, ""
+}
+
+// topGainersLosers makes up small caps for the gainers and losers and
+// ranks the listed active stocks by volume for the most active list
+func topGainersLosers(q url.Values) (interface{}, string) {
+	r := seeded(AsOf + "/movers")
+	mover := func(ticker string, price, change float64, volume int64) alphavintage.Mover {
+		return alphavintage.Mover{
+			Ticker:           ticker,
+			Price:            fmt.Sprintf("%.4f", price),
+			ChangeAmount:     fmt.Sprintf("%.4f", change),
+			ChangePercentage: fmt.Sprintf("%.4f%%", change/(price-change)*100),
+			Volume:           fmt.Sprintf("%d", volume),
+		}
+	}
+	smallCaps := func(n int, sign float64) []alphavintage.Mover {
+		var movers []alphavintage.Mover
+		pct := 3 + r.Float64()
+		for i := 0; i < n; i++ {
+			ticker := make([]byte, 3+r.Intn(2))
+			for j := range ticker {
+				ticker[j] = byte('A' + r.Intn(26))
+			}
+			prev := 0.5 + r.Float64()*20
+			change := prev * pct * sign
+			if sign < 0 {
+				change = -prev * pct / (1 + pct)
+			}
+			movers = append(movers, mover(string(ticker), prev+change, change, int64(1e5+r.Intn(5e7))))
+			pct *= 0.8 + r.Float64()*0.15
+		}
+		return movers
+	}
+
+	type quote struct {
+		symbol     string
+		last, prev bar
+	}
+	var quotes []quote
+	for _, l := range listed {
+		if l.delistingDate != "" {
+			continue
+		}
+		last, prev := lastQuote(l.symbol)
+		quotes = append(quotes, quote{l.symbol, last, prev})
+	}
+	sort.Slice(quotes, func(i, j int) bool { return quotes[i].last.volume > quotes[j].last.volume })
+	var active []alphavintage.Mover
+	for _, q := range quotes {
+		active = append(active, mover(q.symbol, q.last.close, q.last.close-q.prev.close, q.last.volume))
+	}
+
+	return alphavintage.TopMoversResponse{
+		Metadata:           "Top gainers, losers, and most actively traded US tickers",
+		LastUpdated:        AsOf + " 16:15:59 US/Eastern",
+		TopGainers:         smallCaps(20, 1),
+		TopLosers:          smallCaps(20, -1),
+		MostActivelyTraded: active,
+	}, ""
+}
+
+var analysts = [][2]string{
+	{"Morgan Lee", "Analyst, Harbor Securities"},
+	{"Sam Patel", "Analyst, Northgate Capital"},
+	{"Jamie Ortiz", "Analyst, Crestline Research"},
+}
+
+// earningsCallTranscript scripts a call around the EARNINGS numbers for the
+// quarter: prepared remarks from the CEO and CFO, then analyst questions.
+// Management stays upbeat whatever the surprise, analysts follow the numbers
+func earningsCallTranscript(q url.Values) (interface{}, string) {
+	symbol, errMessage := requireSymbol(q)
+	if errMessage != "" {
+		return nil, errMessage
+	}
+	var year, quarter int
+	if _, err := fmt.Sscanf(q.Get("quarter"), "%dQ%d", &year, &quarter); err != nil || quarter < 1 || quarter > 4 {
+		return nil, invalidCall("EARNINGS_CALL_TRANSCRIPT")
+	}
+
+	resp := alphavintage.EarningsCallTranscriptResponse{
+		Symbol:     symbol,
+		Quarter:    q.Get("quarter"),
+		Transcript: []alphavintage.TranscriptSegment{},
+	}
+	payload, _ := earnings(q)
+	end := time.Date(year, time.Month(quarter*3)+1, 0, 0, 0, 0, 0, time.UTC).Format("2006-01-02")
+	var report *alphavintage.QuarterlyEarning
+	for _, e := range payload.(alphavintage.EarningsResponse).QuarterlyEarnings {
+		if e.FiscalDateEnding == end {
+			report = &e
+			break
+		}
+	}
+	if report == nil {
+		return resp, ""
+	}
+
+	r := seeded(symbol + "/" + resp.Quarter)
+	surprise, _ := strconv.ParseFloat(report.SurprisePercentage, 64)
+	analystTone := math.Max(-0.9, math.Min(0.9, surprise/10))
+	segment := func(speaker, title string, tone float64, content string) alphavintage.TranscriptSegment {
+		return alphavintage.TranscriptSegment{
+			Speaker:   speaker,
+			Title:     title,
+			Content:   content,
+			Sentiment: fmt.Sprintf("%.1f", math.Max(-1, math.Min(1, tone+r.NormFloat64()*0.1))),
+		}
+	}
+	ceo, cfo := executives[0], executives[1]
+	ceoTitle, cfoTitle := "Chief Executive Officer", cfo[1]
+
+	resp.Transcript = append(resp.Transcript,
+		segment("Operator", "Operator", 0.3, fmt.Sprintf(
+			"Good day and welcome to the %s %s earnings conference call. I would now like to turn the call over to %s.",
+			symbol, resp.Quarter, ceo[0])),
+		segment(ceo[0], ceoTitle, 0.8, fmt.Sprintf(
+			"Thank you. We delivered another strong quarter, with momentum across every segment. Demand remains healthy and we are raising our confidence in the full year outlook. Earnings per share came in at $%s.",
+			report.ReportedEPS)),
+		segment(cfo[0], cfoTitle, 0.6, fmt.Sprintf(
+			"Thanks. Earnings per share were $%s against consensus of $%s. Margins held up well and we continued to return cash to shareholders while investing for growth.",
+			report.ReportedEPS, report.EstimatedEPS)),
+	)
+	for _, a := range analysts {
+		resp.Transcript = append(resp.Transcript,
+			segment(a[0], a[1], analystTone, fmt.Sprintf(
+				"Thanks for taking my question. Results were %.1f%% versus expectations. Can you talk about what drove that and how it shapes guidance for next quarter?",
+				surprise)),
+			segment(ceo[0], ceoTitle, 0.7,
+				"Great question. We see the underlying trends as very encouraging and we are executing well against our plan."),
+		)
+	}
+	resp.Transcript = append(resp.Transcript,
+		segment("Operator", "Operator", 0.2, "This concludes today's conference call. You may now disconnect."))
+	return resp, ""
+}
@@ -0,0 +1,191 @@
+// Package avtest provides an offline fake of the Alpha Vantage API so code
+// that uses alphavintage.Client can be tested without network access.
+//
+//	srv := avtest.NewServer()
+//	defer srv.Close()
+//
+//	client := srv.Client()
+//	daily, err := client.GetTimeSeriesDaily("IBM", alphavintage.OutputSizeCompact)
+//
+// Payloads are generated deterministically from the symbol, so the same
+// request always returns the same data. The latest trading day is AsOf
+package avtest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/SwanHtetAungPhyo/alphavintage"
+)
+
+// AsOf is the most recent trading day in generated time series
+const AsOf = "2024-12-20"
+
+// Messages returned by the fake, copied from real Alpha Vantage responses
+const (
+	RateLimitNote      = "Thank you for using Alpha Vantage! Our standard API call frequency is 5 calls per minute and 500 calls per day. Please visit https://www.alphavantage.co/premium/ if you would like to target a higher API call frequency."
+	DailyLimitInfo     = "Thank you for using Alpha Vantage! Our standard API rate limit is 25 requests per day. Please subscribe to any of the premium plans at https://www.alphavantage.co/premium/ to instantly remove all daily rate limits."
+	PremiumInfo        = "Thank you for using Alpha Vantage! This is a premium endpoint. You may subscribe to any of the premium plans at https://www.alphavantage.co/premium/ to instantly unlock all premium endpoints"
+	InvalidKeyMessage  = "the parameter apikey is invalid or missing. Please claim your free API key on (https://www.alphavantage.co/support/#api-key). It should take less than 20 seconds."
+	InvalidCallMessage = "Invalid API call. Please retry or visit the documentation (https://www.alphavantage.co/documentation/) for %s."
+)
+
+// Server is a fake Alpha Vantage API backed by httptest.Server
+type Server struct {
+	*httptest.Server
+
+	mu             sync.Mutex
+	requests       []url.Values
+	rateLimitAfter int // Successful requests before every answer is a rate limit Note, -1 = never
+	served         int
+	invalid        map[string]bool
+	premium        map[string]bool
+	rejectedKeys   map[string]bool
+	overrides      map[string]string
+}
+
+// NewServer starts a fake Alpha Vantage server. Call Close when done
+func NewServer() *Server {
+	s := &Server{
+		rateLimitAfter: -1,
+		invalid:        make(map[string]bool),
+		premium:        make(map[string]bool),
+		rejectedKeys:   make(map[string]bool),
+		overrides:      make(map[string]string),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Client returns an alphavintage.Client pointed at the fake
+func (s *Server) Client(opts ...alphavintage.Option) *alphavintage.Client {
+	opts = append([]alphavintage.Option{alphavintage.WithBaseURL(s.URL + "/query")}, opts...)
+	return alphavintage.NewClient("avtest", opts...)
+}
+
+// SetRateLimited makes every following request answer with the rate limit Note
+func (s *Server) SetRateLimited(limited bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if limited {
+		s.rateLimitAfter = s.served
+	} else {
+		s.rateLimitAfter = -1
+	}
+}
+
+// RateLimitAfter serves n more requests normally, then answers every
+// request with the rate limit Note
+func (s *Server) RateLimitAfter(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rateLimitAfter = s.served + n
+}
+
+// MarkInvalid makes requests for symbol answer with "Invalid API call"
+func (s *Server) MarkInvalid(symbol string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.invalid[strings.ToUpper(symbol)] = true
+}
+
+// RequirePremium makes requests for function answer with the premium endpoint message
+func (s *Server) RequirePremium(function string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.premium[function] = true
+}
+
+// RejectKey makes requests using apiKey answer with the invalid key message
+func (s *Server) RejectKey(apiKey string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rejectedKeys[apiKey] = true
+}
+
+// SetResponse serves body verbatim for function instead of the generated payload
+func (s *Server) SetResponse(function, body string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.overrides[function] = body
+}
+
+// Requests returns the query of every request received so far
+func (s *Server) Requests() []url.Values {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]url.Values, len(s.requests))
+	copy(out, s.requests)
+	return out
+}
+
+// RequestCount returns how many requests were received
+func (s *Server) RequestCount() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.requests)
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	function := q.Get("function")
+	symbol := strings.ToUpper(q.Get("symbol"))
+
+	s.mu.Lock()
+	s.requests = append(s.requests, q)
+	rateLimited := s.rateLimitAfter >= 0 && s.served >= s.rateLimitAfter
+	rejected := q.Get("apikey") == "" || s.rejectedKeys[q.Get("apikey")]
+	invalid := symbol != "" && s.invalid[symbol]
+	premium := s.premium[function]
+	override, overridden := s.overrides[function]
+	if !rateLimited && !rejected {
+		s.served++
+	}
+	s.mu.Unlock()
+
+	switch {
+	case rejected:
+		writeJSON(w, map[string]string{"Error Message": InvalidKeyMessage})
+	case rateLimited:
+		writeJSON(w, map[string]string{"Note": RateLimitNote})
+	case premium:
+		writeJSON(w, map[string]string{"Information": PremiumInfo})
+	case invalid:
+		writeJSON(w, map[string]string{"Error Message": invalidCall(function)})
+	case overridden:
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(override))
+	default:
+		h, ok := handlers[function]
+		if !ok {
+			writeJSON(w, map[string]string{"Error Message": "This API function (" + function + ") does not exist."})
+			return
+		}
+		payload, errMessage := h(q)
+		if payload == nil {
+			writeJSON(w, map[string]string{"Error Message": errMessage})
+			return
+		}
+		if csv, ok := payload.(csvPayload); ok {
+			w.Header().Set("Content-Type", "application/x-download")
+			w.Write([]byte(csv))
+			return
+		}
+		writeJSON(w, payload)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	enc.Encode(v)
+}
+
+func invalidCall(function string) string {
+	return strings.Replace(InvalidCallMessage, "%s", function, 1)
+}
@@ -0,0 +1,178 @@
+package avtest
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/SwanHtetAungPhyo/alphavintage"
+)
+
+// calls maps every function the fake serves to the Client method that
+// requests and decodes it
+var calls = map[string]func(c *alphavintage.Client) (interface{}, error){
+	"MARKET_STATUS":      func(c *alphavintage.Client) (interface{}, error) { return c.GetMarketStatus() },
+	"TOP_GAINERS_LOSERS": func(c *alphavintage.Client) (interface{}, error) { return c.GetTopMovers() },
+	"TIME_SERIES_DAILY": func(c *alphavintage.Client) (interface{}, error) {
+		return c.GetTimeSeriesDaily("IBM", alphavintage.OutputSizeCompact)
+	},
+	"TIME_SERIES_INTRADAY": func(c *alphavintage.Client) (interface{}, error) {
+		return c.GetTimeSeriesIntraday("IBM", alphavintage.Interval5Min, alphavintage.OutputSizeCompact)
+	},
+
+	"TIME_SERIES_DAILY_ADJUSTED": func(c *alphavintage.Client) (interface{}, error) {
+		return c.GetTimeSeriesDailyAdjusted("IBM", alphavintage.OutputSizeCompact)
+	},
+	"TIME_SERIES_WEEKLY":           func(c *alphavintage.Client) (interface{}, error) { return c.GetTimeSeriesWeekly("IBM") },
+	"TIME_SERIES_WEEKLY_ADJUSTED":  func(c *alphavintage.Client) (interface{}, error) { return c.GetTimeSeriesWeeklyAdjusted("IBM") },
+	"TIME_SERIES_MONTHLY":          func(c *alphavintage.Client) (interface{}, error) { return c.GetTimeSeriesMonthly("IBM") },
+	"TIME_SERIES_MONTHLY_ADJUSTED": func(c *alphavintage.Client) (interface{}, error) { return c.GetTimeSeriesMonthlyAdjusted("IBM") },
+
+	"GLOBAL_QUOTE": func(c *alphavintage.Client) (interface{}, error) { return c.GetQuote("IBM") },
+	"REALTIME_BULK_QUOTES": func(c *alphavintage.Client) (interface{}, error) {
+		return c.GetBulkQuotes([]string{"IBM", "MSFT"})
+	},
+
+	"SYMBOL_SEARCH":  func(c *alphavintage.Client) (interface{}, error) { return c.SearchSymbols("IBM") },
+	"LISTING_STATUS": func(c *alphavintage.Client) (interface{}, error) { return c.GetListingStatus(nil) },
+
+	"CURRENCY_EXCHANGE_RATE": func(c *alphavintage.Client) (interface{}, error) { return c.GetExchangeRate("EUR", "USD") },
+	"FX_INTRADAY": func(c *alphavintage.Client) (interface{}, error) {
+		return c.GetFXIntraday("EUR", "USD", alphavintage.Interval5Min, alphavintage.OutputSizeCompact)
+	},
+	"FX_DAILY": func(c *alphavintage.Client) (interface{}, error) {
+		return c.GetFXDaily("EUR", "USD", alphavintage.OutputSizeCompact)
+	},
+	"FX_WEEKLY":  func(c *alphavintage.Client) (interface{}, error) { return c.GetFXWeekly("EUR", "USD") },
+	"FX_MONTHLY": func(c *alphavintage.Client) (interface{}, error) { return c.GetFXMonthly("EUR", "USD") },
+
+	"DIGITAL_CURRENCY_DAILY":   func(c *alphavintage.Client) (interface{}, error) { return c.GetDigitalCurrencyDaily("BTC", "USD") },
+	"DIGITAL_CURRENCY_WEEKLY":  func(c *alphavintage.Client) (interface{}, error) { return c.GetDigitalCurrencyWeekly("BTC", "USD") },
+	"DIGITAL_CURRENCY_MONTHLY": func(c *alphavintage.Client) (interface{}, error) { return c.GetDigitalCurrencyMonthly("BTC", "USD") },
+	"CRYPTO_INTRADAY": func(c *alphavintage.Client) (interface{}, error) {
+		return c.GetCryptoIntraday("ETH", "USD", alphavintage.Interval5Min, alphavintage.OutputSizeCompact)
+	},
+
+	"REAL_GDP":            callEconomic(alphavintage.IndicatorRealGDP),
+	"REAL_GDP_PER_CAPITA": callEconomic(alphavintage.IndicatorRealGDPPerCapita),
+	"TREASURY_YIELD": func(c *alphavintage.Client) (interface{}, error) {
+		return c.GetTreasuryYield(alphavintage.IntervalMonthly, alphavintage.Maturity10Year)
+	},
+	"FEDERAL_FUNDS_RATE": callEconomic(alphavintage.IndicatorFederalFundsRate),
+	"CPI":                callEconomic(alphavintage.IndicatorCPI),
+	"INFLATION":          callEconomic(alphavintage.IndicatorInflation),
+	"RETAIL_SALES":       callEconomic(alphavintage.IndicatorRetailSales),
+	"DURABLES":           callEconomic(alphavintage.IndicatorDurables),
+	"UNEMPLOYMENT":       callEconomic(alphavintage.IndicatorUnemployment),
+	"NONFARM_PAYROLL":    callEconomic(alphavintage.IndicatorNonfarmPayroll),
+
+	"WTI":             callCommodity(alphavintage.CommodityWTI),
+	"BRENT":           callCommodity(alphavintage.CommodityBrent),
+	"NATURAL_GAS":     callCommodity(alphavintage.CommodityNaturalGas),
+	"COPPER":          callCommodity(alphavintage.CommodityCopper),
+	"ALUMINUM":        callCommodity(alphavintage.CommodityAluminum),
+	"WHEAT":           callCommodity(alphavintage.CommodityWheat),
+	"CORN":            callCommodity(alphavintage.CommodityCorn),
+	"COTTON":          callCommodity(alphavintage.CommodityCotton),
+	"SUGAR":           callCommodity(alphavintage.CommoditySugar),
+	"COFFEE":          callCommodity(alphavintage.CommodityCoffee),
+	"ALL_COMMODITIES": callCommodity(alphavintage.CommodityAll),
+
+	"SMA":    callTechnical(alphavintage.TechnicalSMA, 20, alphavintage.SeriesClose),
+	"EMA":    callTechnical(alphavintage.TechnicalEMA, 20, alphavintage.SeriesClose),
+	"RSI":    callTechnical(alphavintage.TechnicalRSI, 14, alphavintage.SeriesClose),
+	"BBANDS": callTechnical(alphavintage.TechnicalBBANDS, 20, alphavintage.SeriesClose),
+	"MACD":   callTechnical(alphavintage.TechnicalMACD, 0, alphavintage.SeriesClose),
+	"STOCH":  callTechnical(alphavintage.TechnicalSTOCH, 0, ""),
+	"OBV":    callTechnical(alphavintage.TechnicalOBV, 0, ""),
+
+	"REALTIME_OPTIONS": func(c *alphavintage.Client) (interface{}, error) { return c.GetRealtimeOptions("IBM", true) },
+	"HISTORICAL_OPTIONS": func(c *alphavintage.Client) (interface{}, error) {
+		return c.GetHistoricalOptions("IBM", AsOf)
+	},
+
+	"DIVIDENDS": func(c *alphavintage.Client) (interface{}, error) { return c.GetDividends("IBM") },
+	"SPLITS":    func(c *alphavintage.Client) (interface{}, error) { return c.GetSplits("IBM") },
+
+	"INSIDER_TRANSACTIONS": func(c *alphavintage.Client) (interface{}, error) { return c.GetInsiderTransactions("IBM") },
+	"EARNINGS_CALL_TRANSCRIPT": func(c *alphavintage.Client) (interface{}, error) {
+		return c.GetEarningsCallTranscript("IBM", "2024Q3")
+	},
+
+	"EARNINGS_CALENDAR": func(c *alphavintage.Client) (interface{}, error) { return c.GetEarningsCalendar(nil) },
+	"IPO_CALENDAR":      func(c *alphavintage.Client) (interface{}, error) { return c.GetIPOCalendar() },
+
+	"OVERVIEW":         func(c *alphavintage.Client) (interface{}, error) { return c.GetCompanyOverview("IBM") },
+	"BALANCE_SHEET":    func(c *alphavintage.Client) (interface{}, error) { return c.GetBalanceSheet("IBM") },
+	"INCOME_STATEMENT": func(c *alphavintage.Client) (interface{}, error) { return c.GetIncomeStatement("IBM") },
+	"CASH_FLOW":        func(c *alphavintage.Client) (interface{}, error) { return c.GetCashFlow("IBM") },
+	"EARNINGS":         func(c *alphavintage.Client) (interface{}, error) { return c.GetEarnings("IBM") },
+	"NEWS_SENTIMENT": func(c *alphavintage.Client) (interface{}, error) {
+		return c.GetNewsSentiment(&alphavintage.NewsSentimentOptions{Tickers: "IBM", Limit: 10})
+	},
+}
+
+func callEconomic(indicator alphavintage.EconomicIndicator) func(c *alphavintage.Client) (interface{}, error) {
+	return func(c *alphavintage.Client) (interface{}, error) { return c.GetEconomicIndicator(indicator, nil) }
+}
+
+func callCommodity(commodity alphavintage.EconomicIndicator) func(c *alphavintage.Client) (interface{}, error) {
+	return func(c *alphavintage.Client) (interface{}, error) {
+		return c.GetCommodity(commodity, alphavintage.IntervalMonthly)
+	}
+}
+
+func callTechnical(indicator alphavintage.TechnicalIndicator, period int, series alphavintage.SeriesType) func(c *alphavintage.Client) (interface{}, error) {
+	return func(c *alphavintage.Client) (interface{}, error) {
+		return c.GetTechnicalIndicator(indicator, "IBM", &alphavintage.IndicatorOptions{TimePeriod: period, SeriesType: series})
+	}
+}
+
+func TestHandlersDecode(t *testing.T) {
+	srv := NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	for function := range handlers {
+		t.Run(function, func(t *testing.T) {
+			call, ok := calls[function]
+			if !ok {
+				t.Fatalf("no Client method mapped to %s", function)
+			}
+
+			before := srv.RequestCount()
+			got, err := call(client)
+			if err != nil {
+				t.Fatalf("decode failed: %v", err)
+			}
+			if empty(got) {
+				t.Fatalf("decoded an empty %T", got)
+			}
+
+			requests := srv.Requests()[before:]
+			if len(requests) != 1 || requests[0].Get("function") != function {
+				t.Fatalf("mapped call sent %v; want one %s request", requests, function)
+			}
+		})
+	}
+}
+
+func TestCallsMatchHandlers(t *testing.T) {
+	for function := range calls {
+		if _, ok := handlers[function]; !ok {
+			t.Errorf("%s is mapped to a Client method but not served", function)
+		}
+	}
+}
+
+// empty reports whether a decoded result is a nil pointer, an empty slice
+// or a struct without any field set
+func empty(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		return rv.IsNil() || rv.Elem().IsZero()
+	case reflect.Slice:
+		return rv.Len() == 0
+	}
+	return rv.IsZero()
+}
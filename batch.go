@@ -0,0 +1,242 @@
+package alphavintage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Dataset selects what FetchBatch retrieves for each symbol
+type Dataset string
+
+const (
+	DatasetDaily        Dataset = "daily"
+	DatasetEarnings     Dataset = "earnings"
+	DatasetCashFlow     Dataset = "cash_flow"
+	DatasetBalanceSheet Dataset = "balance_sheet"
+	DatasetNews         Dataset = "news"
+	DatasetOverview     Dataset = "overview"
+	DatasetIncome       Dataset = "income_statement"
+	DatasetDividends    Dataset = "dividends"
+	DatasetSplits       Dataset = "splits"
+)
+
+// AllDatasets lists the datasets FetchBatch retrieves when
+// BatchOptions.Datasets is nil. DatasetOverview, DatasetIncome,
+// DatasetDividends and DatasetSplits cost one more request per symbol each
+// and are only fetched when listed in BatchOptions.Datasets
+var AllDatasets = []Dataset{DatasetDaily, DatasetEarnings, DatasetCashFlow, DatasetBalanceSheet, DatasetNews}
+
+// BatchOptions configures FetchBatch
+type BatchOptions struct {
+	Datasets    []Dataset  // nil = AllDatasets
+	Concurrency int        // Requests in flight at once, 0 = 4
+	OutputSize  OutputSize // Daily series size, empty = compact
+	NewsLimit   int        // Articles per symbol, 0 = 50
+}
+
+// SymbolResult holds everything FetchBatch retrieved for one symbol.
+// A dataset that failed is nil and its error is in Errors
+type SymbolResult struct {
+	Symbol       string
+	Daily        *TimeSeriesDailyResponse
+	Earnings     *EarningsResponse
+	CashFlow     *CashFlowResponse
+	BalanceSheet *BalanceSheetResponse
+	News         *NewsSentimentResponse
+	Overview     *CompanyOverview
+	Income       *IncomeStatementResponse
+	Dividends    *DividendsResponse
+	Splits       *SplitsResponse
+	Errors       map[Dataset]error
+}
+
+// Err joins the errors of every failed dataset, or returns nil if all succeeded
+func (r *SymbolResult) Err() error {
+	datasets := make([]string, 0, len(r.Errors))
+	for ds := range r.Errors {
+		datasets = append(datasets, string(ds))
+	}
+	sort.Strings(datasets)
+
+	var errs []error
+	for _, ds := range datasets {
+		errs = append(errs, fmt.Errorf("%s: %w", ds, r.Errors[Dataset(ds)]))
+	}
+	return errors.Join(errs...)
+}
+
+// AnalysisData converts the result for the AI client and report builder.
+// When DatasetDividends or DatasetSplits were fetched, the AI prompts use
+// back-adjusted prices
+func (r *SymbolResult) AnalysisData() StockAnalysisData {
+	return StockAnalysisData{
+		Symbol:       r.Symbol,
+		Daily:        r.Daily,
+		Earnings:     r.Earnings,
+		CashFlow:     r.CashFlow,
+		BalanceSheet: r.BalanceSheet,
+		News:         r.News,
+		Overview:     r.Overview,
+		Income:       r.Income,
+		Dividends:    r.Dividends,
+		Splits:       r.Splits,
+	}
+}
+
+// FetchBatch fetches datasets for many symbols concurrently
+func (c *Client) FetchBatch(symbols []string, opts *BatchOptions) []SymbolResult {
+	return c.FetchBatchCtx(context.Background(), symbols, opts)
+}
+
+// FetchBatchCtx is like FetchBatch but aborts when ctx is done.
+// Requests still go through the client's rate limiter, retry policy and
+// cache. A failure only affects its own symbol and dataset; results are
+// returned in the order of symbols
+func (c *Client) FetchBatchCtx(ctx context.Context, symbols []string, opts *BatchOptions) []SymbolResult {
+	if opts == nil {
+		opts = &BatchOptions{}
+	}
+	datasets := opts.Datasets
+	if datasets == nil {
+		datasets = AllDatasets
+	}
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = 4
+	}
+
+	results := make([]SymbolResult, len(symbols))
+	for i, symbol := range symbols {
+		results[i] = SymbolResult{Symbol: strings.ToUpper(symbol), Errors: make(map[Dataset]error)}
+	}
+
+	type job struct {
+		result  *SymbolResult
+		dataset Dataset
+	}
+	// Jobs are queued symbol by symbol so that, when the daily budget runs
+	// out, the earlier symbols are complete rather than every symbol partial
+	jobs := make(chan job)
+	go func() {
+		defer close(jobs)
+		for i := range results {
+			for _, ds := range datasets {
+				jobs <- job{&results[i], ds}
+			}
+		}
+	}()
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				err := ctx.Err()
+				if err == nil {
+					err = c.fetchDataset(ctx, j.result, j.dataset, opts, &mu)
+				}
+				if err != nil {
+					mu.Lock()
+					j.result.Errors[j.dataset] = err
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return results
+}
+
+func (c *Client) fetchDataset(ctx context.Context, r *SymbolResult, ds Dataset, opts *BatchOptions, mu *sync.Mutex) error {
+	switch ds {
+	case DatasetDaily:
+		outputSize := opts.OutputSize
+		if outputSize == "" {
+			outputSize = OutputSizeCompact
+		}
+		data, err := c.GetTimeSeriesDailyCtx(ctx, r.Symbol, outputSize)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		r.Daily = data
+		mu.Unlock()
+	case DatasetEarnings:
+		data, err := c.GetEarningsCtx(ctx, r.Symbol)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		r.Earnings = data
+		mu.Unlock()
+	case DatasetCashFlow:
+		data, err := c.GetCashFlowCtx(ctx, r.Symbol)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		r.CashFlow = data
+		mu.Unlock()
+	case DatasetBalanceSheet:
+		data, err := c.GetBalanceSheetCtx(ctx, r.Symbol)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		r.BalanceSheet = data
+		mu.Unlock()
+	case DatasetNews:
+		limit := opts.NewsLimit
+		if limit <= 0 {
+			limit = 50
+		}
+		data, err := c.GetNewsSentimentCtx(ctx, &NewsSentimentOptions{Tickers: r.Symbol, Limit: limit})
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		r.News = data
+		mu.Unlock()
+	case DatasetOverview:
+		data, err := c.GetCompanyOverviewCtx(ctx, r.Symbol)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		r.Overview = data
+		mu.Unlock()
+	case DatasetIncome:
+		data, err := c.GetIncomeStatementCtx(ctx, r.Symbol)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		r.Income = data
+		mu.Unlock()
+	case DatasetDividends:
+		data, err := c.GetDividendsCtx(ctx, r.Symbol)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		r.Dividends = data
+		mu.Unlock()
+	case DatasetSplits:
+		data, err := c.GetSplitsCtx(ctx, r.Symbol)
+		if err != nil {
+			return err
+		}
+		mu.Lock()
+		r.Splits = data
+		mu.Unlock()
+	default:
+		return fmt.Errorf("unknown dataset %q", ds)
+	}
+	return nil
+}
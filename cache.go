@@ -0,0 +1,310 @@
+package alphavintage
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache stores raw Alpha Vantage response bodies. Implementations must be
+// safe for concurrent use
+type Cache interface {
+	Get(key string) ([]byte, bool)
+	Set(key string, value []byte, ttl time.Duration)
+	Delete(key string)
+}
+
+// CacheTTLFunc decides how long a response for an Alpha Vantage function
+// stays fresh. Returning 0 disables caching for that function
+type CacheTTLFunc func(function string, now time.Time) time.Duration
+
+// DefaultCacheTTL keeps daily, weekly and monthly series until the next
+// market close, fundamentals for a week and doesn't cache market status
+func DefaultCacheTTL(function string, now time.Time) time.Duration {
+	switch function {
+	case "TIME_SERIES_DAILY", "TIME_SERIES_DAILY_ADJUSTED",
+		"TIME_SERIES_WEEKLY", "TIME_SERIES_WEEKLY_ADJUSTED",
+		"TIME_SERIES_MONTHLY", "TIME_SERIES_MONTHLY_ADJUSTED":
+		// Weekly and monthly series update their latest point every day
+		return untilNextMarketClose(now)
+	case "OVERVIEW":
+		// Market cap, ratios and moving averages follow the daily close
+		return untilNextMarketClose(now)
+	case "DIVIDENDS", "SPLITS":
+		// Declared and effective dates are published with the daily data
+		return untilNextMarketClose(now)
+	case "TIME_SERIES_INTRADAY", "GLOBAL_QUOTE", "REALTIME_BULK_QUOTES",
+		"CURRENCY_EXCHANGE_RATE", "FX_INTRADAY", "CRYPTO_INTRADAY",
+		"REALTIME_OPTIONS", "TOP_GAINERS_LOSERS":
+		return time.Minute
+	case "HISTORICAL_OPTIONS":
+		// Past dates never change; without a date it's the previous session
+		return untilNextMarketClose(now)
+	case "DIGITAL_CURRENCY_DAILY", "DIGITAL_CURRENCY_WEEKLY", "DIGITAL_CURRENCY_MONTHLY":
+		// Digital currencies trade every day and close at midnight UTC
+		return untilNextUTCClose(now)
+	case "FX_DAILY", "FX_WEEKLY", "FX_MONTHLY":
+		// FX trades around the clock, so there is no close to wait for
+		return time.Hour
+	case "BALANCE_SHEET", "INCOME_STATEMENT", "CASH_FLOW", "EARNINGS":
+		return 7 * 24 * time.Hour
+	case "EARNINGS_CALL_TRANSCRIPT":
+		// Published transcripts don't change, but a recent call may not be
+		// out yet
+		return 24 * time.Hour
+	case "NEWS_SENTIMENT":
+		return 15 * time.Minute
+	case "INSIDER_TRANSACTIONS":
+		// Form 4 filings arrive throughout the day
+		return time.Hour
+	case "SMA", "EMA", "WMA", "DEMA", "TEMA", "VWAP", "MACD", "STOCH", "RSI",
+		"STOCHRSI", "WILLR", "ADX", "CCI", "AROON", "MFI", "BBANDS", "AD",
+		"OBV", "ATR", "SAR":
+		// The interval isn't known here, so assume the latest bar is still forming
+		return time.Minute
+	case "WTI", "BRENT", "NATURAL_GAS", "COPPER", "ALUMINUM", "WHEAT",
+		"CORN", "COTTON", "SUGAR", "COFFEE", "ALL_COMMODITIES",
+		"TREASURY_YIELD", "FEDERAL_FUNDS_RATE":
+		// Daily series publish after the US close
+		return untilNextMarketClose(now)
+	case "REAL_GDP", "REAL_GDP_PER_CAPITA", "CPI", "INFLATION",
+		"RETAIL_SALES", "DURABLES", "UNEMPLOYMENT", "NONFARM_PAYROLL":
+		// Monthly or slower releases
+		return 24 * time.Hour
+	case "LISTING_STATUS", "EARNINGS_CALENDAR", "IPO_CALENDAR":
+		// Regenerated once per trading day
+		return untilNextMarketClose(now)
+	case "SYMBOL_SEARCH":
+		return 24 * time.Hour
+	}
+	return 0
+}
+
+var newYork = loadNewYork()
+
+func loadNewYork() *time.Location {
+	if loc, err := time.LoadLocation("America/New_York"); err == nil {
+		return loc
+	}
+	// No tzdata available, EST is close enough for cache expiry
+	return time.FixedZone("EST", -5*60*60)
+}
+
+// untilNextMarketClose returns the time left until 16:15 New York time on the
+// next weekday, which gives Alpha Vantage a few minutes to publish the bar
+func untilNextMarketClose(now time.Time) time.Duration {
+	local := now.In(newYork)
+	next := time.Date(local.Year(), local.Month(), local.Day(), 16, 15, 0, 0, newYork)
+	if !next.After(local) {
+		next = next.AddDate(0, 0, 1)
+	}
+	for next.Weekday() == time.Saturday || next.Weekday() == time.Sunday {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next.Sub(now)
+}
+
+// untilNextUTCClose returns the time left until 00:15 UTC, when the daily
+// digital currency bar has been published. Weekends count like any other day
+func untilNextUTCClose(now time.Time) time.Duration {
+	utc := now.UTC()
+	next := time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 15, 0, 0, time.UTC)
+	if !next.After(utc) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next.Sub(now)
+}
+
+// CacheKey returns the cache key for a set of query params. Keys are sorted,
+// the API key is dropped and the symbol is upper-cased so equivalent
+// queries share an entry
+func CacheKey(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		if k == "apikey" {
+			continue
+		}
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		v := params[k]
+		if k == "symbol" {
+			v = strings.ToUpper(v)
+		}
+		parts = append(parts, url.QueryEscape(k)+"="+url.QueryEscape(v))
+	}
+	return strings.Join(parts, "&")
+}
+
+type cacheBypassKey struct{}
+
+// BypassCache returns a context that makes the client skip cache lookups.
+// The fresh response still replaces the cached one
+func BypassCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, cacheBypassKey{}, true)
+}
+
+func cacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(cacheBypassKey{}).(bool)
+	return bypass
+}
+
+// MemoryCache is an in-memory LRU cache
+type MemoryCache struct {
+	capacity int
+
+	mu      sync.Mutex
+	order   *list.List // Front is most recently used
+	entries map[string]*list.Element
+}
+
+type memoryEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryCache creates an LRU cache holding at most capacity responses
+func NewMemoryCache(capacity int) *MemoryCache {
+	if capacity <= 0 {
+		capacity = 100
+	}
+	return &MemoryCache{
+		capacity: capacity,
+		order:    list.New(),
+		entries:  make(map[string]*list.Element),
+	}
+}
+
+// Get returns a cached response if present and not expired
+func (m *MemoryCache) Get(key string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	el, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := el.Value.(*memoryEntry)
+	if time.Now().After(entry.expires) {
+		m.order.Remove(el)
+		delete(m.entries, key)
+		return nil, false
+	}
+	m.order.MoveToFront(el)
+	return entry.value, true
+}
+
+// Set stores a response, evicting the least recently used one when full
+func (m *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	expires := time.Now().Add(ttl)
+	if el, ok := m.entries[key]; ok {
+		entry := el.Value.(*memoryEntry)
+		entry.value = value
+		entry.expires = expires
+		m.order.MoveToFront(el)
+		return
+	}
+
+	m.entries[key] = m.order.PushFront(&memoryEntry{key: key, value: value, expires: expires})
+	for m.order.Len() > m.capacity {
+		oldest := m.order.Back()
+		m.order.Remove(oldest)
+		delete(m.entries, oldest.Value.(*memoryEntry).key)
+	}
+}
+
+// Delete removes a cached response
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if el, ok := m.entries[key]; ok {
+		m.order.Remove(el)
+		delete(m.entries, key)
+	}
+}
+
+// DirCache stores responses as files in a directory so they survive restarts
+type DirCache struct {
+	dir string
+}
+
+type dirEntry struct {
+	Key     string    `json:"key"`
+	Expires time.Time `json:"expires"`
+	Value   []byte    `json:"value"`
+}
+
+// NewDirCache creates a cache in dir, creating the directory if needed
+func NewDirCache(dir string) (*DirCache, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return &DirCache{dir: dir}, nil
+}
+
+func (d *DirCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(d.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get returns a cached response if present and not expired
+func (d *DirCache) Get(key string) ([]byte, bool) {
+	data, err := os.ReadFile(d.path(key))
+	if err != nil {
+		return nil, false
+	}
+	var entry dirEntry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.Key != key {
+		return nil, false
+	}
+	if time.Now().After(entry.Expires) {
+		os.Remove(d.path(key))
+		return nil, false
+	}
+	return entry.Value, true
+}
+
+// Set writes a response to disk. Write errors are ignored; a failed write
+// only means the next call goes to the API
+func (d *DirCache) Set(key string, value []byte, ttl time.Duration) {
+	data, err := json.Marshal(dirEntry{Key: key, Expires: time.Now().Add(ttl), Value: value})
+	if err != nil {
+		return
+	}
+	// Write then rename so concurrent readers never see a partial file
+	tmp, err := os.CreateTemp(d.dir, "tmp-*")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(data)
+	tmp.Close()
+	if err != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), d.path(key)); err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+// Delete removes a cached response
+func (d *DirCache) Delete(key string) {
+	os.Remove(d.path(key))
+}
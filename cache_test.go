@@ -0,0 +1,127 @@
+package alphavintage
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMemoryCacheLRU(t *testing.T) {
+	c := NewMemoryCache(2)
+	c.Set("a", []byte("1"), time.Hour)
+	c.Set("b", []byte("2"), time.Hour)
+
+	// Reading a makes b the least recently used entry
+	if _, ok := c.Get("a"); !ok {
+		t.Fatal("a missing")
+	}
+	c.Set("c", []byte("3"), time.Hour)
+
+	if _, ok := c.Get("b"); ok {
+		t.Fatal("b survived; want it evicted as least recently used")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok := c.Get(key); !ok {
+			t.Fatalf("%s evicted", key)
+		}
+	}
+
+	// Overwriting refreshes an entry instead of adding one
+	c.Set("a", []byte("4"), time.Hour)
+	c.Set("d", []byte("5"), time.Hour)
+	if v, ok := c.Get("a"); !ok || string(v) != "4" {
+		t.Fatalf("Get(a) = %q, %v; want the overwritten value", v, ok)
+	}
+	if _, ok := c.Get("c"); ok {
+		t.Fatal("c survived; want it evicted after a was overwritten")
+	}
+}
+
+func TestMemoryCacheExpiry(t *testing.T) {
+	c := NewMemoryCache(10)
+	c.Set("fresh", []byte("1"), time.Hour)
+	c.Set("stale", []byte("2"), -time.Second)
+
+	if _, ok := c.Get("fresh"); !ok {
+		t.Fatal("fresh entry missing")
+	}
+	if _, ok := c.Get("stale"); ok {
+		t.Fatal("expired entry returned")
+	}
+	if _, ok := c.entries["stale"]; ok {
+		t.Fatal("expired entry kept after Get")
+	}
+
+	c.Delete("fresh")
+	if _, ok := c.Get("fresh"); ok {
+		t.Fatal("deleted entry returned")
+	}
+}
+
+func TestDirCache(t *testing.T) {
+	c, err := NewDirCache(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.Set("function=OVERVIEW&symbol=IBM", []byte(`{"Symbol":"IBM"}`), time.Hour)
+	if v, ok := c.Get("function=OVERVIEW&symbol=IBM"); !ok || string(v) != `{"Symbol":"IBM"}` {
+		t.Fatalf("Get = %q, %v; want the stored body", v, ok)
+	}
+	if _, ok := c.Get("function=OVERVIEW&symbol=MSFT"); ok {
+		t.Fatal("Get returned an entry that was never stored")
+	}
+
+	c.Set("function=EARNINGS&symbol=IBM", []byte("{}"), -time.Second)
+	if _, ok := c.Get("function=EARNINGS&symbol=IBM"); ok {
+		t.Fatal("expired entry returned")
+	}
+
+	c.Delete("function=OVERVIEW&symbol=IBM")
+	if _, ok := c.Get("function=OVERVIEW&symbol=IBM"); ok {
+		t.Fatal("deleted entry returned")
+	}
+}
+
+func TestCacheKey(t *testing.T) {
+	a := CacheKey(map[string]string{"function": "TIME_SERIES_DAILY", "symbol": "ibm", "outputsize": "compact", "apikey": "secret"})
+	b := CacheKey(map[string]string{"outputsize": "compact", "symbol": "IBM", "function": "TIME_SERIES_DAILY", "apikey": "other"})
+
+	want := "function=TIME_SERIES_DAILY&outputsize=compact&symbol=IBM"
+	if a != want {
+		t.Fatalf("CacheKey = %q; want %q", a, want)
+	}
+	if a != b {
+		t.Fatalf("equivalent queries got different keys %q and %q", a, b)
+	}
+
+	// Only the symbol is case-insensitive
+	if c := CacheKey(map[string]string{"function": "NEWS_SENTIMENT", "topics": "Technology"}); c != "function=NEWS_SENTIMENT&topics=Technology" {
+		t.Fatalf("CacheKey = %q; want other values kept as-is", c)
+	}
+}
+
+func TestUntilNextMarketClose(t *testing.T) {
+	at := func(day, hour, min int) time.Time {
+		return time.Date(2024, 12, day, hour, min, 0, 0, newYork)
+	}
+
+	tests := []struct {
+		name string
+		now  time.Time
+		want time.Duration
+	}{
+		{"weekday before close", at(19, 10, 0), 6*time.Hour + 15*time.Minute},
+		{"weekday after 16:15", at(19, 16, 30), 23*time.Hour + 45*time.Minute},
+		{"weekday at 16:15", at(19, 16, 15), 24 * time.Hour},
+		{"friday after close", at(20, 17, 0), 71*time.Hour + 15*time.Minute},
+		{"saturday", at(21, 10, 0), 54*time.Hour + 15*time.Minute},
+		{"sunday", at(22, 23, 0), 17*time.Hour + 15*time.Minute},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := untilNextMarketClose(tt.now); got != tt.want {
+				t.Fatalf("untilNextMarketClose(%v) = %v; want %v", tt.now, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+package alphavintage
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+// CalendarHorizon is how far ahead the earnings calendar looks
+type CalendarHorizon string
+
+const (
+	Horizon3Month  CalendarHorizon = "3month"
+	Horizon6Month  CalendarHorizon = "6month"
+	Horizon12Month CalendarHorizon = "12month"
+)
+
+// EarningsCalendarOptions contains options for earnings calendar API
+type EarningsCalendarOptions struct {
+	Symbol  string          // Empty = every company
+	Horizon CalendarHorizon // Empty = 3 months
+}
+
+// GetEarningsCalendar returns the companies expected to report earnings
+// within the horizon
+func (c *Client) GetEarningsCalendar(opts *EarningsCalendarOptions) ([]EarningsCalendarEntry, error) {
+	return c.GetEarningsCalendarCtx(context.Background(), opts)
+}
+
+// GetEarningsCalendarCtx is like GetEarningsCalendar but aborts when ctx is done
+func (c *Client) GetEarningsCalendarCtx(ctx context.Context, opts *EarningsCalendarOptions) ([]EarningsCalendarEntry, error) {
+	params := map[string]string{
+		"function": "EARNINGS_CALENDAR",
+	}
+
+	if opts != nil {
+		if opts.Symbol != "" {
+			params["symbol"] = opts.Symbol
+		}
+		if opts.Horizon != "" {
+			params["horizon"] = string(opts.Horizon)
+		}
+	}
+
+	body, err := c.doRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := parseCSV(body)
+	if err != nil {
+		return nil, avDecodeError(params, err)
+	}
+
+	entries := make([]EarningsCalendarEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, EarningsCalendarEntry{
+			Symbol:           row["symbol"],
+			Name:             row["name"],
+			ReportDate:       row["reportDate"],
+			FiscalDateEnding: row["fiscalDateEnding"],
+			Estimate:         row["estimate"],
+			Currency:         row["currency"],
+		})
+	}
+	return entries, nil
+}
+
+// GetIPOCalendar returns the IPOs expected in the next 3 months
+func (c *Client) GetIPOCalendar() ([]IPOCalendarEntry, error) {
+	return c.GetIPOCalendarCtx(context.Background())
+}
+
+// GetIPOCalendarCtx is like GetIPOCalendar but aborts when ctx is done
+func (c *Client) GetIPOCalendarCtx(ctx context.Context) ([]IPOCalendarEntry, error) {
+	params := map[string]string{
+		"function": "IPO_CALENDAR",
+	}
+
+	body, err := c.doRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := parseCSV(body)
+	if err != nil {
+		return nil, avDecodeError(params, err)
+	}
+
+	entries := make([]IPOCalendarEntry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, IPOCalendarEntry{
+			Symbol:         row["symbol"],
+			Name:           row["name"],
+			IPODate:        row["ipoDate"],
+			PriceRangeLow:  row["priceRangeLow"],
+			PriceRangeHigh: row["priceRangeHigh"],
+			Currency:       row["currency"],
+			Exchange:       row["exchange"],
+		})
+	}
+	return entries, nil
+}
+
+// UpcomingEvent is an earnings report or IPO on a given date
+type UpcomingEvent struct {
+	Date   string
+	Symbol string
+	Name   string
+	Event  string // "Earnings" or "IPO"
+	Detail string // EPS estimate or price range
+}
+
+// UpcomingEvents merges earnings and IPO calendar entries for the symbols
+// in watchlist (all of them when watchlist is empty), sorted by date
+func UpcomingEvents(earnings []EarningsCalendarEntry, ipos []IPOCalendarEntry, watchlist []string) []UpcomingEvent {
+	watched := make(map[string]bool, len(watchlist))
+	for _, s := range watchlist {
+		watched[strings.ToUpper(s)] = true
+	}
+	keep := func(symbol string) bool {
+		return len(watched) == 0 || watched[strings.ToUpper(symbol)]
+	}
+
+	var events []UpcomingEvent
+	for _, e := range earnings {
+		if !keep(e.Symbol) {
+			continue
+		}
+		detail := "No estimate"
+		if e.Estimate != "" {
+			detail = "EPS est. " + e.Estimate + " " + e.Currency
+		}
+		events = append(events, UpcomingEvent{e.ReportDate, e.Symbol, e.Name, "Earnings", detail})
+	}
+	for _, i := range ipos {
+		if !keep(i.Symbol) {
+			continue
+		}
+		detail := "Price range not set"
+		if i.PriceRangeLow != "" && i.PriceRangeLow != "0" {
+			detail = i.PriceRangeLow + "-" + i.PriceRangeHigh + " " + i.Currency + " on " + i.Exchange
+		}
+		events = append(events, UpcomingEvent{i.IPODate, i.Symbol, i.Name, "IPO", detail})
+	}
+
+	sort.SliceStable(events, func(a, b int) bool {
+		if events[a].Date != events[b].Date {
+			return events[a].Date < events[b].Date
+		}
+		return events[a].Symbol < events[b].Symbol
+	})
+	return events
+}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/wcharczuk/go-chart/v2"
@@ -18,6 +19,10 @@ type ChartOptions struct {
 	Height     int
 	Title      string
 	ShowVolume bool
+	// Overlays draws indicator columns over the price line of the daily,
+	// candlestick and intraday charts. They share the price axis, so use
+	// price-scale indicators such as SMA, EMA, VWAP or BBANDS
+	Overlays []*IndicatorSeries
 }
 
 // DefaultChartOptions returns default chart options
@@ -42,7 +47,7 @@ func GenerateDailyPriceChart(data *TimeSeriesDailyResponse, output io.Writer, op
 		opts.Height = 600
 	}
 	if opts.Title == "" {
-		opts.Title = fmt.Sprintf("%s Daily Price", data.MetaData.Symbol)
+		opts.Title = fmt.Sprintf("%s %s Price", data.MetaData.Symbol, seriesPeriod(data.MetaData))
 	}
 
 	// Sort dates and extract data
@@ -75,7 +80,7 @@ func GenerateDailyPriceChart(data *TimeSeriesDailyResponse, output io.Writer, op
 				return fmt.Sprintf("$%.2f", v.(float64))
 			},
 		},
-		Series: []chart.Series{priceSeries},
+		Series: append([]chart.Series{priceSeries}, overlaySeries(opts.Overlays, dates)...),
 	}
 
 	// Add volume bars if requested
@@ -105,6 +110,73 @@ func GenerateDailyPriceChart(data *TimeSeriesDailyResponse, output io.Writer, op
 	return graph.Render(chart.PNG, output)
 }
 
+var overlayColors = []drawing.Color{
+	drawing.ColorFromHex("ff7f0e"),
+	drawing.ColorFromHex("9467bd"),
+	drawing.ColorFromHex("8c564b"),
+	drawing.ColorFromHex("e377c2"),
+	drawing.ColorFromHex("17becf"),
+	drawing.ColorFromHex("bcbd22"),
+}
+
+// overlaySeries turns every column of the overlays into a line, clipped to
+// the time range of the price data so the x axis isn't stretched
+func overlaySeries(overlays []*IndicatorSeries, priceTimes []time.Time) []chart.Series {
+	if len(priceTimes) == 0 {
+		return nil
+	}
+	from, to := priceTimes[0], priceTimes[len(priceTimes)-1]
+
+	var series []chart.Series
+	for _, o := range overlays {
+		if o == nil {
+			continue
+		}
+		for _, column := range o.Columns {
+			times, values := o.Column(column)
+			var xs []time.Time
+			var ys []float64
+			for i, t := range times {
+				if t.Before(from) || t.After(to) {
+					continue
+				}
+				xs = append(xs, t)
+				ys = append(ys, values[i])
+			}
+			if len(xs) < 2 {
+				continue
+			}
+
+			name := column
+			if len(o.Columns) == 1 && o.MetaData.TimePeriod != "" {
+				name = fmt.Sprintf("%s(%s)", column, o.MetaData.TimePeriod)
+			}
+			series = append(series, chart.TimeSeries{
+				Name:    name,
+				XValues: xs,
+				YValues: ys,
+				Style: chart.Style{
+					StrokeColor: overlayColors[len(series)%len(overlayColors)],
+					StrokeWidth: 1.5,
+				},
+			})
+		}
+	}
+	return series
+}
+
+// seriesPeriod names the bar period of a series from its metadata, so charts
+// of weekly and monthly series get a matching default title
+func seriesPeriod(meta TimeSeriesMetaData) string {
+	switch {
+	case strings.HasPrefix(meta.Information, "Weekly"):
+		return "Weekly"
+	case strings.HasPrefix(meta.Information, "Monthly"):
+		return "Monthly"
+	}
+	return "Daily"
+}
+
 // GenerateDailyPriceChartToFile saves chart to a PNG file
 func GenerateDailyPriceChartToFile(data *TimeSeriesDailyResponse, filename string, opts ChartOptions) error {
 	f, err := os.Create(filename)
@@ -213,7 +285,7 @@ func GenerateCandlestickChart(data *TimeSeriesDailyResponse, output io.Writer, o
 				return fmt.Sprintf("$%.2f", v.(float64))
 			},
 		},
-		Series: []chart.Series{highSeries, lowSeries, closeSeries},
+		Series: append([]chart.Series{highSeries, lowSeries, closeSeries}, overlaySeries(opts.Overlays, dates)...),
 	}
 
 	graph.Elements = []chart.Renderable{chart.Legend(&graph)}
@@ -567,6 +639,172 @@ func GenerateCashFlowChartToFile(data *CashFlowResponse, filename string, opts C
 }
 
 
+// GenerateIncomeStatementChart creates a revenue and net income chart from annual reports
+func GenerateIncomeStatementChart(data *IncomeStatementResponse, output io.Writer, opts ChartOptions) error {
+	if data == nil || len(data.AnnualReports) == 0 {
+		return fmt.Errorf("no income statement data to chart")
+	}
+
+	if opts.Width == 0 {
+		opts.Width = 1000
+	}
+	if opts.Height == 0 {
+		opts.Height = 500
+	}
+	if opts.Title == "" {
+		opts.Title = fmt.Sprintf("%s Revenue & Net Income", data.Symbol)
+	}
+
+	currency, revenue, netIncome := incomeChartSeries(data.AnnualReports)
+	if len(revenue.XValues) < 2 {
+		return fmt.Errorf("need at least 2 annual reports to chart")
+	}
+
+	revenue.Style = chart.Style{StrokeColor: chart.ColorBlue, StrokeWidth: 2}
+	series := []chart.Series{revenue}
+	if len(netIncome.XValues) >= 2 {
+		netIncome.Style = chart.Style{StrokeColor: chart.ColorGreen, StrokeWidth: 2}
+		series = append(series, netIncome)
+	}
+
+	graph := chart.Chart{
+		Title:      opts.Title,
+		TitleStyle: chart.Style{FontSize: 14},
+		Width:      opts.Width,
+		Height:     opts.Height,
+		XAxis: chart.XAxis{
+			Name:           "Year",
+			ValueFormatter: chart.TimeDateValueFormatter,
+		},
+		YAxis: chart.YAxis{
+			Name: currency + " (billions)",
+			ValueFormatter: func(v interface{}) string {
+				return fmt.Sprintf("%.1fB", v.(float64))
+			},
+		},
+		Series: series,
+	}
+
+	graph.Elements = []chart.Renderable{chart.Legend(&graph)}
+
+	return graph.Render(chart.PNG, output)
+}
+
+// incomeChartSeries returns revenue and net income in billions for the last
+// 10 fiscal years, oldest first, and the currency of the latest report.
+// Values that don't parse, such as "None", are left out rather than drawn as 0
+func incomeChartSeries(reports []IncomeStatementReport) (string, chart.TimeSeries, chart.TimeSeries) {
+	type dated struct {
+		date   time.Time
+		report IncomeStatementReport
+	}
+	var sorted []dated
+	for _, r := range reports {
+		t, err := time.Parse("2006-01-02", r.FiscalDateEnding)
+		if err != nil {
+			continue
+		}
+		sorted = append(sorted, dated{t, r})
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].date.Before(sorted[j].date)
+	})
+	if len(sorted) > 10 {
+		sorted = sorted[len(sorted)-10:]
+	}
+
+	currency := "USD"
+	revenue := chart.TimeSeries{Name: "Revenue"}
+	netIncome := chart.TimeSeries{Name: "Net Income"}
+	for _, d := range sorted {
+		if d.report.ReportedCurrency != "" {
+			currency = d.report.ReportedCurrency
+		}
+		if v, err := strconv.ParseFloat(d.report.TotalRevenue, 64); err == nil {
+			revenue.XValues = append(revenue.XValues, d.date)
+			revenue.YValues = append(revenue.YValues, v/1e9)
+		}
+		if v, err := strconv.ParseFloat(d.report.NetIncome, 64); err == nil {
+			netIncome.XValues = append(netIncome.XValues, d.date)
+			netIncome.YValues = append(netIncome.YValues, v/1e9)
+		}
+	}
+	return currency, revenue, netIncome
+}
+
+// GenerateIncomeStatementChartToFile saves income statement chart to PNG file
+func GenerateIncomeStatementChartToFile(data *IncomeStatementResponse, filename string, opts ChartOptions) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return GenerateIncomeStatementChart(data, f, opts)
+}
+
+// GenerateEconomicChart creates a line chart of an economic indicator or
+// commodity series
+func GenerateEconomicChart(series *EconomicSeries, output io.Writer, opts ChartOptions) error {
+	if series == nil || len(series.Data) == 0 {
+		return fmt.Errorf("no data to chart")
+	}
+
+	if opts.Width == 0 {
+		opts.Width = 1000
+	}
+	if opts.Height == 0 {
+		opts.Height = 500
+	}
+	if opts.Title == "" {
+		opts.Title = series.Name
+	}
+
+	dates, values := series.Values()
+	if len(dates) < 2 {
+		return fmt.Errorf("need at least 2 observations to chart")
+	}
+
+	graph := chart.Chart{
+		Title:      opts.Title,
+		TitleStyle: chart.Style{FontSize: 14},
+		Width:      opts.Width,
+		Height:     opts.Height,
+		XAxis: chart.XAxis{
+			Name:           "Date",
+			ValueFormatter: chart.TimeDateValueFormatter,
+		},
+		YAxis: chart.YAxis{
+			Name: series.Unit,
+			ValueFormatter: func(v interface{}) string {
+				return fmt.Sprintf("%.2f", v.(float64))
+			},
+		},
+		Series: []chart.Series{
+			chart.TimeSeries{
+				Name:    series.Name,
+				XValues: dates,
+				YValues: values,
+				Style: chart.Style{
+					StrokeColor: chart.ColorBlue,
+					StrokeWidth: 2,
+				},
+			},
+		},
+	}
+
+	return graph.Render(chart.PNG, output)
+}
+
+// GenerateEconomicChartToFile saves economic series chart to PNG file
+func GenerateEconomicChartToFile(series *EconomicSeries, filename string, opts ChartOptions) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return GenerateEconomicChart(series, f, opts)
+}
+
 // GenerateIntradayChart creates a chart from intraday data (single day)
 func GenerateIntradayChart(data *TimeSeriesIntradayResponse, output io.Writer, opts ChartOptions) error {
 	if data == nil || len(data.TimeSeries) == 0 {
@@ -643,7 +881,7 @@ func GenerateIntradayChart(data *TimeSeriesIntradayResponse, output io.Writer, o
 				return fmt.Sprintf("$%.2f", v.(float64))
 			},
 		},
-		Series: []chart.Series{priceSeries},
+		Series: append([]chart.Series{priceSeries}, overlaySeries(opts.Overlays, times)...),
 	}
 
 	if opts.ShowVolume && len(volumes) > 0 {
@@ -0,0 +1,53 @@
+package alphavintage
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOverlaySeriesSkipsNil(t *testing.T) {
+	sma := &IndicatorSeries{
+		MetaData: IndicatorMetaData{TimePeriod: "20"},
+		Columns:  []string{"SMA"},
+		Points: []IndicatorPoint{
+			{Time: "2024-12-18", Values: map[string]float64{"SMA": 101}},
+			{Time: "2024-12-19", Values: map[string]float64{"SMA": 102}},
+			{Time: "2024-12-20", Values: map[string]float64{"SMA": 103}},
+		},
+	}
+	priceTimes := []time.Time{
+		time.Date(2024, 12, 18, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 12, 20, 0, 0, 0, 0, time.UTC),
+	}
+
+	series := overlaySeries([]*IndicatorSeries{nil, sma, nil}, priceTimes)
+	if len(series) != 1 {
+		t.Fatalf("got %d overlay series; want 1", len(series))
+	}
+	if name := series[0].GetName(); name != "SMA(20)" {
+		t.Fatalf("series name = %q; want SMA(20)", name)
+	}
+}
+
+func TestIncomeChartSeries(t *testing.T) {
+	reports := []IncomeStatementReport{
+		{FiscalDateEnding: "2023-03-31", ReportedCurrency: "JPY", TotalRevenue: "3000000000000", NetIncome: "None"},
+		{FiscalDateEnding: "2021-03-31", ReportedCurrency: "JPY", TotalRevenue: "1000000000000", NetIncome: "100000000000"},
+		{FiscalDateEnding: "2022-03-31", ReportedCurrency: "JPY", TotalRevenue: "None", NetIncome: "200000000000"},
+	}
+
+	currency, revenue, netIncome := incomeChartSeries(reports)
+	if currency != "JPY" {
+		t.Fatalf("currency = %q; want JPY from the reports", currency)
+	}
+	// "None" is left out instead of being plotted as 0
+	if len(revenue.YValues) != 2 || revenue.YValues[0] != 1000 || revenue.YValues[1] != 3000 {
+		t.Fatalf("revenue = %v; want [1000 3000]", revenue.YValues)
+	}
+	if revenue.XValues[1].Year() != 2023 {
+		t.Fatalf("revenue dates = %v; want the 2022 report skipped", revenue.XValues)
+	}
+	if len(netIncome.YValues) != 2 || netIncome.YValues[0] != 100 || netIncome.YValues[1] != 200 {
+		t.Fatalf("net income = %v; want [100 200]", netIncome.YValues)
+	}
+}
@@ -1,6 +1,7 @@
 package alphavintage
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -12,34 +13,162 @@ const baseURL = "https://www.alphavantage.co/query"
 
 // Client is the Alpha Vantage API client
 type Client struct {
-	apiKey string
-	resty  *resty.Client
+	apiKey   string
+	baseURL  string
+	resty    *resty.Client
+	limiter  *RateLimiter
+	keys     *KeyPool
+	retry    *RetryPolicy
+	cache    Cache
+	cacheTTL CacheTTLFunc
 }
 
 // NewClient creates a new Alpha Vantage client
-func NewClient(apiKey string) *Client {
+func NewClient(apiKey string, opts ...Option) *Client {
+	cfg := newHTTPConfig(baseURL, opts)
 	return &Client{
-		apiKey: apiKey,
-		resty:  resty.New().SetTimeout(30 * time.Second),
+		apiKey:  apiKey,
+		baseURL: cfg.baseURL,
+		resty:   cfg.newResty(30 * time.Second),
 	}
 }
 
 // WithRestyClient sets a custom resty client
+//
+// Deprecated: pass the WithRestyClient option to NewClient instead
 func (c *Client) WithRestyClient(client *resty.Client) *Client {
 	c.resty = client
 	return c
 }
 
-func (c *Client) doRequest(params map[string]string) ([]byte, error) {
-	params["apikey"] = c.apiKey
+// WithRateLimit throttles requests according to policy
+func (c *Client) WithRateLimit(policy RateLimitPolicy) *Client {
+	c.limiter = NewRateLimiter(policy)
+	return c
+}
+
+// WithRateLimiter uses an existing limiter, e.g. one shared with other clients
+func (c *Client) WithRateLimiter(limiter *RateLimiter) *Client {
+	c.limiter = limiter
+	return c
+}
+
+// WithKeyPool sends requests with the keys of pool instead of the key passed
+// to NewClient, moving to the next key on rate limits and invalid keys
+func (c *Client) WithKeyPool(pool *KeyPool) *Client {
+	c.keys = pool
+	return c
+}
+
+// RemainingDailyBudget returns how many requests the rate limiter and key
+// pool allow for the rest of the day, the smaller of the two when both have
+// a daily budget, or -1 when neither has one
+func (c *Client) RemainingDailyBudget() int {
+	remaining := -1
+	if c.limiter != nil {
+		remaining = c.limiter.RemainingToday()
+	}
+	if c.keys != nil {
+		if keys := c.keys.RemainingToday(); keys >= 0 && (remaining < 0 || keys < remaining) {
+			remaining = keys
+		}
+	}
+	return remaining
+}
+
+// WithRetry retries rate-limited and transient failures according to policy
+func (c *Client) WithRetry(policy RetryPolicy) *Client {
+	c.retry = &policy
+	return c
+}
+
+// WithCache caches successful responses. ttl decides how long each function's
+// responses stay fresh; nil uses DefaultCacheTTL
+func (c *Client) WithCache(cache Cache, ttl CacheTTLFunc) *Client {
+	if ttl == nil {
+		ttl = DefaultCacheTTL
+	}
+	c.cache = cache
+	c.cacheTTL = ttl
+	return c
+}
+
+// InvalidateCache drops the cached response for a query, e.g.
+// map[string]string{"function": "EARNINGS", "symbol": "IBM"}
+func (c *Client) InvalidateCache(params map[string]string) {
+	if c.cache != nil {
+		c.cache.Delete(CacheKey(params))
+	}
+}
+
+func (c *Client) doRequest(ctx context.Context, params map[string]string) ([]byte, error) {
+	var key string
+	var ttl time.Duration
+	if c.cache != nil {
+		ttl = c.cacheTTL(params["function"], time.Now())
+		if ttl > 0 {
+			key = CacheKey(params)
+			if !cacheBypassed(ctx) {
+				if body, ok := c.cache.Get(key); ok {
+					return body, nil
+				}
+			}
+		}
+	}
+
+	body, err := c.retry.do(ctx, func() ([]byte, error) {
+		return c.doRequestOnce(ctx, params)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if key != "" {
+		c.cache.Set(key, body, ttl)
+	}
+	return body, nil
+}
 
-	resp, err := c.resty.R().SetQueryParams(params).Get(baseURL)
+func (c *Client) doRequestOnce(ctx context.Context, params map[string]string) ([]byte, error) {
+	if c.keys != nil {
+		// The pool may fail over and send several requests, each one
+		// takes its own slot
+		return c.keys.do(ctx, func(apiKey string) ([]byte, error) {
+			return c.limitedSend(ctx, params, apiKey)
+		})
+	}
+	return c.limitedSend(ctx, params, c.apiKey)
+}
+
+func (c *Client) limitedSend(ctx context.Context, params map[string]string, apiKey string) ([]byte, error) {
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return c.send(ctx, params, apiKey)
+}
+
+func (c *Client) send(ctx context.Context, params map[string]string, apiKey string) ([]byte, error) {
+	params["apikey"] = apiKey
+
+	resp, err := c.resty.R().SetContext(ctx).SetQueryParams(params).Get(c.baseURL)
 	if err != nil {
+		// Surface cancellation and deadlines as-is so callers can
+		// tell them apart from API failures with errors.Is
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
 
 	if resp.StatusCode() != 200 {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode())
+		return nil, &HTTPError{
+			Provider:   ProviderAlphaVantage,
+			Function:   params["function"],
+			Symbol:     params["symbol"],
+			StatusCode: resp.StatusCode(),
+		}
 	}
 
 	body := resp.Body()
@@ -51,14 +180,23 @@ func (c *Client) doRequest(params map[string]string) ([]byte, error) {
 		Information  string `json:"Information"`
 	}
 	if json.Unmarshal(body, &apiErr) == nil {
-		if apiErr.ErrorMessage != "" {
-			return nil, fmt.Errorf("API error: %s", apiErr.ErrorMessage)
-		}
-		if apiErr.Note != "" {
-			return nil, fmt.Errorf("API rate limit: %s", apiErr.Note)
+		field, message := "", ""
+		switch {
+		case apiErr.ErrorMessage != "":
+			field, message = "Error Message", apiErr.ErrorMessage
+		case apiErr.Note != "":
+			field, message = "Note", apiErr.Note
+		case apiErr.Information != "":
+			field, message = "Information", apiErr.Information
 		}
-		if apiErr.Information != "" {
-			return nil, fmt.Errorf("API info: %s", apiErr.Information)
+		if message != "" {
+			return nil, &APIError{
+				Kind:     classifyAVMessage(field, message, params["symbol"]),
+				Provider: ProviderAlphaVantage,
+				Function: params["function"],
+				Symbol:   params["symbol"],
+				Message:  message,
+			}
 		}
 	}
 
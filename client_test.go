@@ -0,0 +1,488 @@
+package alphavintage_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SwanHtetAungPhyo/alphavintage"
+	"github.com/SwanHtetAungPhyo/alphavintage/avtest"
+)
+
+func TestClientRetry(t *testing.T) {
+	dailyLimit := fmt.Sprintf(`{"Information": %q}`, avtest.DailyLimitInfo)
+
+	tests := []struct {
+		name         string
+		policy       alphavintage.RetryPolicy
+		setup        func(srv *avtest.Server)
+		wantRequests int
+		wantErr      error
+	}{
+		{
+			name:         "success",
+			policy:       alphavintage.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+			setup:        func(srv *avtest.Server) {},
+			wantRequests: 1,
+		},
+		{
+			name:         "rate limited until attempts run out",
+			policy:       alphavintage.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+			setup:        func(srv *avtest.Server) { srv.SetRateLimited(true) },
+			wantRequests: 3,
+			wantErr:      alphavintage.ErrRateLimited,
+		},
+		{
+			name:         "daily quota is permanent",
+			policy:       alphavintage.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+			setup:        func(srv *avtest.Server) { srv.SetResponse("TIME_SERIES_DAILY", dailyLimit) },
+			wantRequests: 1,
+			wantErr:      alphavintage.ErrRateLimited,
+		},
+		{
+			name:         "invalid symbol is permanent",
+			policy:       alphavintage.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond},
+			setup:        func(srv *avtest.Server) { srv.MarkInvalid("IBM") },
+			wantRequests: 1,
+			wantErr:      alphavintage.ErrInvalidSymbol,
+		},
+		{
+			name:         "retries disabled",
+			policy:       alphavintage.RetryPolicy{MaxAttempts: 1, BaseDelay: time.Millisecond},
+			setup:        func(srv *avtest.Server) { srv.SetRateLimited(true) },
+			wantRequests: 1,
+			wantErr:      alphavintage.ErrRateLimited,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := avtest.NewServer()
+			defer srv.Close()
+			tt.setup(srv)
+
+			client := srv.Client().WithRetry(tt.policy)
+			_, err := client.GetTimeSeriesDaily("IBM", alphavintage.OutputSizeCompact)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v; want %v", err, tt.wantErr)
+			}
+			if got := srv.RequestCount(); got != tt.wantRequests {
+				t.Fatalf("server got %d requests; want %d", got, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestClientMissingSeries(t *testing.T) {
+	metaOnly := `{"Meta Data": {"1. Information": "Weekly Prices", "2. Symbol": "IBM"}}`
+
+	calls := map[string]func(client *alphavintage.Client) error{
+		"TIME_SERIES_WEEKLY": func(client *alphavintage.Client) error {
+			_, err := client.GetTimeSeriesWeekly("IBM")
+			return err
+		},
+		"TIME_SERIES_DAILY_ADJUSTED": func(client *alphavintage.Client) error {
+			_, err := client.GetTimeSeriesDailyAdjusted("IBM", alphavintage.OutputSizeCompact)
+			return err
+		},
+		"FX_DAILY": func(client *alphavintage.Client) error {
+			_, err := client.GetFXDaily("EUR", "USD", alphavintage.OutputSizeCompact)
+			return err
+		},
+		"DIGITAL_CURRENCY_DAILY": func(client *alphavintage.Client) error {
+			_, err := client.GetDigitalCurrencyDaily("BTC", "EUR")
+			return err
+		},
+		"CRYPTO_INTRADAY": func(client *alphavintage.Client) error {
+			_, err := client.GetCryptoIntraday("ETH", "USD", alphavintage.Interval5Min, alphavintage.OutputSizeCompact)
+			return err
+		},
+	}
+	for function, call := range calls {
+		t.Run(function, func(t *testing.T) {
+			srv := avtest.NewServer()
+			defer srv.Close()
+			srv.SetResponse(function, metaOnly)
+
+			err := call(srv.Client())
+			var apiErr *alphavintage.APIError
+			if !errors.As(err, &apiErr) || apiErr.Kind != alphavintage.ErrAPI || apiErr.Function != function {
+				t.Fatalf("err = %v; want an APIError for the missing series", err)
+			}
+		})
+	}
+}
+
+func TestClientBypassCache(t *testing.T) {
+	srv := avtest.NewServer()
+	defer srv.Close()
+
+	cache := alphavintage.NewMemoryCache(10)
+	client := srv.Client().WithCache(cache, nil)
+
+	if _, err := client.GetCompanyOverview("IBM"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetCompanyOverview("ibm"); err != nil {
+		t.Fatal(err)
+	}
+	if got := srv.RequestCount(); got != 1 {
+		t.Fatalf("server got %d requests; want the second served from cache", got)
+	}
+
+	// Bypassing skips the lookup but still writes the fresh body through
+	key := alphavintage.CacheKey(map[string]string{"function": "OVERVIEW", "symbol": "IBM"})
+	cache.Set(key, []byte(`{"Symbol": "STALE"}`), time.Hour)
+	overview, err := client.GetCompanyOverviewCtx(alphavintage.BypassCache(context.Background()), "IBM")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if overview.Symbol != "IBM" {
+		t.Fatalf("bypassed request returned %q; want the fresh response", overview.Symbol)
+	}
+	if got := srv.RequestCount(); got != 2 {
+		t.Fatalf("server got %d requests; want 2", got)
+	}
+	if body, ok := cache.Get(key); !ok || strings.Contains(string(body), "STALE") {
+		t.Fatalf("cache holds %q; want the fresh response written through", body)
+	}
+
+	if _, err := client.GetCompanyOverview("IBM"); err != nil {
+		t.Fatal(err)
+	}
+	if got := srv.RequestCount(); got != 2 {
+		t.Fatalf("server got %d requests; want the refreshed entry served from cache", got)
+	}
+}
+
+func TestClientErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		setup   func(srv *avtest.Server)
+		symbol  string
+		wantErr error
+	}{
+		{"invalid symbol", func(srv *avtest.Server) { srv.MarkInvalid("xyzz") }, "XYZZ", alphavintage.ErrInvalidSymbol},
+		{"other symbol unaffected", func(srv *avtest.Server) { srv.MarkInvalid("XYZZ") }, "IBM", nil},
+		{"premium", func(srv *avtest.Server) { srv.RequirePremium("OVERVIEW") }, "IBM", alphavintage.ErrPremiumRequired},
+		{"rate limited", func(srv *avtest.Server) { srv.SetRateLimited(true) }, "IBM", alphavintage.ErrRateLimited},
+		{"rejected key", func(srv *avtest.Server) { srv.RejectKey("avtest") }, "IBM", alphavintage.ErrInvalidAPIKey},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := avtest.NewServer()
+			defer srv.Close()
+			tt.setup(srv)
+
+			_, err := srv.Client().GetCompanyOverview(tt.symbol)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v; want %v", err, tt.wantErr)
+			}
+			var apiErr *alphavintage.APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("err = %T; want *APIError", err)
+			}
+			if apiErr.Provider != alphavintage.ProviderAlphaVantage || apiErr.Function != "OVERVIEW" || apiErr.Symbol != tt.symbol {
+				t.Fatalf("APIError = %+v; want the OVERVIEW request for %s", apiErr, tt.symbol)
+			}
+		})
+	}
+}
+
+func TestClientRateLimitAfter(t *testing.T) {
+	srv := avtest.NewServer()
+	defer srv.Close()
+	srv.RateLimitAfter(2)
+	client := srv.Client()
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetQuote("IBM"); err != nil {
+			t.Fatalf("request %d: %v", i+1, err)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := client.GetQuote("IBM"); !errors.Is(err, alphavintage.ErrRateLimited) {
+			t.Fatalf("request %d after the limit: err = %v; want ErrRateLimited", i+3, err)
+		}
+	}
+
+	srv.SetRateLimited(false)
+	if _, err := client.GetQuote("IBM"); err != nil {
+		t.Fatalf("request after lifting the limit: %v", err)
+	}
+}
+
+func TestClientRemainingDailyBudget(t *testing.T) {
+	srv := avtest.NewServer()
+	defer srv.Close()
+
+	newPool := func() *alphavintage.KeyPool {
+		return alphavintage.NewKeyPool([]string{"key-a", "key-b"}, alphavintage.KeyRoundRobin).
+			WithRateLimit(alphavintage.RateLimitPolicy{RequestsPerDay: 3})
+	}
+
+	tests := []struct {
+		name   string
+		client func() *alphavintage.Client
+		want   int // After 4 quote requests
+	}{
+		{"no budget", func() *alphavintage.Client { return srv.Client() }, -1},
+		{"limiter only", func() *alphavintage.Client {
+			return srv.Client().WithRateLimit(alphavintage.RateLimitPolicy{RequestsPerDay: 10})
+		}, 6},
+		{"pool only", func() *alphavintage.Client { return srv.Client().WithKeyPool(newPool()) }, 2},
+		{"limiter without daily budget", func() *alphavintage.Client {
+			return srv.Client().WithRateLimit(alphavintage.RateLimitPolicy{RequestsPerMinute: 1000}).WithKeyPool(newPool())
+		}, 2},
+		{"pool is smaller", func() *alphavintage.Client {
+			return srv.Client().WithRateLimit(alphavintage.RateLimitPolicy{RequestsPerDay: 10}).WithKeyPool(newPool())
+		}, 2},
+		{"limiter is smaller", func() *alphavintage.Client {
+			return srv.Client().WithRateLimit(alphavintage.RateLimitPolicy{RequestsPerDay: 5}).WithKeyPool(newPool())
+		}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := tt.client()
+			for i := 0; i < 4; i++ {
+				if _, err := client.GetQuote("IBM"); err != nil {
+					t.Fatalf("request %d: %v", i+1, err)
+				}
+			}
+			if got := client.RemainingDailyBudget(); got != tt.want {
+				t.Fatalf("RemainingDailyBudget = %d; want %d", got, tt.want)
+			}
+		})
+	}
+
+	// Every key out of budget reads as 0 even behind a limiter without one
+	client := srv.Client().WithRateLimit(alphavintage.RateLimitPolicy{RequestsPerMinute: 1000}).WithKeyPool(newPool())
+	for i := 0; i < 6; i++ {
+		client.GetQuote("IBM")
+	}
+	if _, err := client.GetQuote("IBM"); !errors.Is(err, alphavintage.ErrKeysExhausted) {
+		t.Fatalf("err = %v; want ErrKeysExhausted", err)
+	}
+	if got := client.RemainingDailyBudget(); got != 0 {
+		t.Fatalf("RemainingDailyBudget with every key exhausted = %d; want 0", got)
+	}
+}
+
+func TestClientKeyPoolFailover(t *testing.T) {
+	srv := avtest.NewServer()
+	defer srv.Close()
+	srv.RejectKey("revoked-key-0001")
+
+	pool := alphavintage.NewKeyPool([]string{"revoked-key-0001", "working-key-0002"}, alphavintage.KeyFailover)
+	client := srv.Client().WithRateLimit(alphavintage.RateLimitPolicy{RequestsPerDay: 10}).WithKeyPool(pool)
+
+	for i := 0; i < 3; i++ {
+		if _, err := client.GetQuote("IBM"); err != nil {
+			t.Fatalf("request %d: %v", i+1, err)
+		}
+	}
+
+	var keys []string
+	for _, q := range srv.Requests() {
+		keys = append(keys, q.Get("apikey"))
+	}
+	want := []string{"revoked-key-0001", "working-key-0002", "working-key-0002", "working-key-0002"}
+	if strings.Join(keys, ",") != strings.Join(want, ",") {
+		t.Fatalf("requests used keys %v; want %v", keys, want)
+	}
+
+	stats := pool.Stats()
+	if !stats[0].Invalid || stats[0].Key != "****0001" || stats[0].Requests != 1 {
+		t.Fatalf("Stats[0] = %+v; want the rejected key marked invalid", stats[0])
+	}
+	if stats[1].Invalid || stats[1].Key != "****0002" || stats[1].Requests != 3 {
+		t.Fatalf("Stats[1] = %+v; want 3 requests on the working key", stats[1])
+	}
+	// The failover request counts against the client budget too
+	if got := client.RemainingDailyBudget(); got != 6 {
+		t.Fatalf("RemainingDailyBudget = %d; want 6 after 4 requests", got)
+	}
+
+	// With no usable key left the last API error is reported
+	srv.RejectKey("working-key-0002")
+	if _, err := client.GetQuote("IBM"); !errors.Is(err, alphavintage.ErrInvalidAPIKey) {
+		t.Fatalf("err = %v; want the invalid key error from the last key", err)
+	}
+	if _, err := client.GetQuote("IBM"); !errors.Is(err, alphavintage.ErrKeysExhausted) {
+		t.Fatalf("err = %v; want ErrKeysExhausted once every key is invalid", err)
+	}
+}
+
+func TestFetchBatchDatasets(t *testing.T) {
+	srv := avtest.NewServer()
+	defer srv.Close()
+	client := srv.Client()
+
+	results := client.FetchBatch([]string{"IBM", "MSFT"}, nil)
+	if got, want := srv.RequestCount(), 2*len(alphavintage.AllDatasets); got != want {
+		t.Fatalf("default batch sent %d requests; want %d", got, want)
+	}
+	for _, r := range results {
+		if err := r.Err(); err != nil {
+			t.Fatalf("%s: %v", r.Symbol, err)
+		}
+		if r.Overview != nil || r.Income != nil || r.Dividends != nil || r.Splits != nil {
+			t.Fatalf("%s: opt-in datasets fetched without being requested", r.Symbol)
+		}
+	}
+
+	results = client.FetchBatch([]string{"IBM"}, &alphavintage.BatchOptions{
+		Datasets: []alphavintage.Dataset{alphavintage.DatasetOverview, alphavintage.DatasetIncome,
+			alphavintage.DatasetDividends, alphavintage.DatasetSplits},
+	})
+	if r := results[0]; r.Err() != nil || r.Overview == nil || r.Income == nil || r.Dividends == nil || r.Splits == nil {
+		t.Fatalf("opt-in datasets not fetched: %v", r.Err())
+	}
+}
+
+func TestFetchBatchErrors(t *testing.T) {
+	srv := avtest.NewServer()
+	defer srv.Close()
+	srv.MarkInvalid("BAD")
+	srv.RequirePremium("CASH_FLOW")
+
+	datasets := []alphavintage.Dataset{alphavintage.DatasetDaily, alphavintage.DatasetCashFlow}
+	results := srv.Client().FetchBatch([]string{"IBM", "BAD", "MSFT"}, &alphavintage.BatchOptions{Datasets: datasets})
+	if len(results) != 3 {
+		t.Fatalf("got %d results; want 3", len(results))
+	}
+	for _, r := range results {
+		if r.Symbol == "BAD" {
+			if r.Daily != nil || !errors.Is(r.Errors[alphavintage.DatasetDaily], alphavintage.ErrInvalidSymbol) {
+				t.Fatalf("BAD daily = %v; want ErrInvalidSymbol", r.Errors[alphavintage.DatasetDaily])
+			}
+			continue
+		}
+		// The failing symbol and dataset leave the others alone
+		if r.Daily == nil || r.Errors[alphavintage.DatasetDaily] != nil {
+			t.Fatalf("%s daily failed: %v", r.Symbol, r.Errors[alphavintage.DatasetDaily])
+		}
+		if r.CashFlow != nil || !errors.Is(r.Errors[alphavintage.DatasetCashFlow], alphavintage.ErrPremiumRequired) {
+			t.Fatalf("%s cash flow = %v; want ErrPremiumRequired", r.Symbol, r.Errors[alphavintage.DatasetCashFlow])
+		}
+		if len(r.Errors) != 1 {
+			t.Fatalf("%s errors = %v; want only the cash flow error", r.Symbol, r.Errors)
+		}
+	}
+}
+
+// cancelTransport cancels its context once n requests have completed
+type cancelTransport struct {
+	n      int
+	cancel context.CancelFunc
+}
+
+func (c *cancelTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := http.DefaultTransport.RoundTrip(req)
+	if c.n--; c.n == 0 {
+		c.cancel()
+	}
+	return resp, err
+}
+
+func TestFetchBatchCanceled(t *testing.T) {
+	srv := avtest.NewServer()
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := srv.Client(alphavintage.WithTransport(&cancelTransport{n: 1, cancel: cancel}))
+
+	results := client.FetchBatchCtx(ctx, []string{"IBM", "MSFT"}, &alphavintage.BatchOptions{
+		Datasets:    []alphavintage.Dataset{alphavintage.DatasetDaily, alphavintage.DatasetEarnings},
+		Concurrency: 1,
+	})
+	if results[0].Daily == nil {
+		t.Fatalf("IBM daily failed before the cancel: %v", results[0].Errors[alphavintage.DatasetDaily])
+	}
+	// Every job after the cancel is filled in with the ctx error
+	remaining := []error{
+		results[0].Errors[alphavintage.DatasetEarnings],
+		results[1].Errors[alphavintage.DatasetDaily],
+		results[1].Errors[alphavintage.DatasetEarnings],
+	}
+	for i, err := range remaining {
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("remaining job %d err = %v; want context.Canceled", i, err)
+		}
+	}
+	if n := srv.RequestCount(); n != 1 {
+		t.Fatalf("%d requests sent; want 1", n)
+	}
+}
+
+// blockingTransport holds every request until its context is done
+type blockingTransport struct {
+	started chan struct{}
+}
+
+func (b *blockingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	b.started <- struct{}{}
+	<-req.Context().Done()
+	return nil, req.Context().Err()
+}
+
+func TestContextCancellation(t *testing.T) {
+	srv := avtest.NewServer()
+	defer srv.Close()
+
+	retry := alphavintage.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+	calls := map[string]func(ctx context.Context, transport http.RoundTripper) error{
+		"alphavantage": func(ctx context.Context, transport http.RoundTripper) error {
+			_, err := srv.Client(alphavintage.WithTransport(transport)).WithRetry(retry).GetQuoteCtx(ctx, "IBM")
+			return err
+		},
+		"financialdatasets": func(ctx context.Context, transport http.RoundTripper) error {
+			client := alphavintage.NewFinancialDatasetsClient("fd-key", alphavintage.WithBaseURL(srv.URL), alphavintage.WithTransport(transport))
+			_, err := client.WithRetry(retry).GetIncomeStatementsCtx(ctx, "IBM", alphavintage.FDPeriodAnnual, 1)
+			return err
+		},
+		"openrouter": func(ctx context.Context, transport http.RoundTripper) error {
+			ai := alphavintage.NewAIClient(alphavintage.AIConfig{APIKey: "or-key"}, alphavintage.WithBaseURL(srv.URL), alphavintage.WithTransport(transport))
+			_, err := ai.WithRetry(retry).CustomAnalysisCtx(ctx, alphavintage.StockAnalysisData{Symbol: "IBM"}, "Summarize")
+			return err
+		},
+	}
+
+	for provider, call := range calls {
+		t.Run(provider+"/canceled", func(t *testing.T) {
+			transport := &blockingTransport{started: make(chan struct{}, 10)}
+			ctx, cancel := context.WithCancel(context.Background())
+			go func() {
+				<-transport.started
+				cancel()
+			}()
+			if err := call(ctx, transport); !errors.Is(err, context.Canceled) {
+				t.Fatalf("err = %v; want context.Canceled", err)
+			}
+		})
+		t.Run(provider+"/deadline", func(t *testing.T) {
+			transport := &blockingTransport{started: make(chan struct{}, 10)}
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+			if err := call(ctx, transport); !errors.Is(err, context.DeadlineExceeded) {
+				t.Fatalf("err = %v; want context.DeadlineExceeded", err)
+			}
+			if n := len(transport.started); n != 1 {
+				t.Fatalf("%d requests in flight; want 1 without retries", n)
+			}
+		})
+	}
+}
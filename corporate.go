@@ -0,0 +1,146 @@
+package alphavintage
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+)
+
+// GetDividends returns the dividend history of a symbol, including declared
+// future dividends
+func (c *Client) GetDividends(symbol string) (*DividendsResponse, error) {
+	return c.GetDividendsCtx(context.Background(), symbol)
+}
+
+// GetDividendsCtx is like GetDividends but aborts when ctx is done
+func (c *Client) GetDividendsCtx(ctx context.Context, symbol string) (*DividendsResponse, error) {
+	params := map[string]string{
+		"function": "DIVIDENDS",
+		"symbol":   symbol,
+	}
+
+	body, err := c.doRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result DividendsResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, avDecodeError(params, err)
+	}
+
+	return &result, nil
+}
+
+// GetSplits returns the split history of a symbol
+func (c *Client) GetSplits(symbol string) (*SplitsResponse, error) {
+	return c.GetSplitsCtx(context.Background(), symbol)
+}
+
+// GetSplitsCtx is like GetSplits but aborts when ctx is done
+func (c *Client) GetSplitsCtx(ctx context.Context, symbol string) (*SplitsResponse, error) {
+	params := map[string]string{
+		"function": "SPLITS",
+		"symbol":   symbol,
+	}
+
+	body, err := c.doRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SplitsResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, avDecodeError(params, err)
+	}
+
+	return &result, nil
+}
+
+// AdjustDaily back-adjusts a daily series so every price is comparable with
+// the latest one. Prices before a split are divided by its factor and
+// volumes multiplied by it; prices before an ex-dividend date are scaled by
+// 1 - dividend / previous close. Either splits or dividends may be nil. The
+// series should be unadjusted, e.g. from GetTimeSeriesDaily
+func AdjustDaily(data *TimeSeriesDailyResponse, splits *SplitsResponse, dividends *DividendsResponse) *TimeSeriesDailyResponse {
+	if data == nil {
+		return nil
+	}
+
+	dates := GetSortedDates(data)
+
+	// Walk back from the latest date, picking up each event once the
+	// walk passes its effective date
+	type event struct {
+		date  string
+		split float64 // Factor, 0 for a dividend
+		cash  float64 // Dividend amount
+	}
+	var events []event
+	if splits != nil {
+		for _, s := range splits.Data {
+			if f, err := parseFloat(s.SplitFactor); err == nil && f > 0 {
+				events = append(events, event{date: s.EffectiveDate, split: f})
+			}
+		}
+	}
+	if dividends != nil {
+		for _, d := range dividends.Data {
+			if a, err := parseFloat(d.Amount); err == nil && a > 0 {
+				events = append(events, event{date: d.ExDividendDate, cash: a})
+			}
+		}
+	}
+
+	adjusted := &TimeSeriesDailyResponse{
+		MetaData:   data.MetaData,
+		TimeSeries: make(map[string]DailyDataPoint, len(data.TimeSeries)),
+	}
+	adjusted.MetaData.Information += " (split and dividend adjusted)"
+
+	priceFactor, volumeFactor := 1.0, 1.0
+	for i := len(dates) - 1; i >= 0; i-- {
+		date := dates[i]
+		point := data.TimeSeries[date]
+		adjusted.TimeSeries[date] = DailyDataPoint{
+			Open:   scalePrice(point.Open, priceFactor),
+			High:   scalePrice(point.High, priceFactor),
+			Low:    scalePrice(point.Low, priceFactor),
+			Close:  scalePrice(point.Close, priceFactor),
+			Volume: scaleVolume(point.Volume, volumeFactor),
+		}
+
+		if i == 0 {
+			break
+		}
+		// Events taking effect after the previous date and on or before this
+		// one apply to everything earlier
+		prev := dates[i-1]
+		for _, e := range events {
+			if e.date <= prev || e.date > date {
+				continue
+			}
+			if e.split > 0 {
+				priceFactor /= e.split
+				volumeFactor *= e.split
+				continue
+			}
+			// The dividend factor uses the unadjusted close before the ex-date
+			if close, err := parseFloat(data.TimeSeries[prev].Close); err == nil && close > e.cash {
+				priceFactor *= 1 - e.cash/close
+			}
+		}
+	}
+	return adjusted
+}
+
+func scaleVolume(volume string, factor float64) string {
+	if factor == 1 {
+		return volume
+	}
+	v, err := parseFloat(volume)
+	if err != nil {
+		return volume
+	}
+	return strconv.FormatFloat(v*factor, 'f', 0, 64)
+}
@@ -0,0 +1,52 @@
+package alphavintage
+
+import "testing"
+
+func TestAdjustDaily(t *testing.T) {
+	data := &TimeSeriesDailyResponse{
+		TimeSeries: map[string]DailyDataPoint{
+			"2024-06-06": {Open: "100.0000", High: "110.0000", Low: "90.0000", Close: "100.0000", Volume: "1000"},
+			"2024-06-07": {Open: "104.0000", High: "104.0000", Low: "104.0000", Close: "104.0000", Volume: "1000"},
+			"2024-06-10": {Open: "26.0000", High: "27.0000", Low: "25.0000", Close: "26.0000", Volume: "4000"},
+			"2024-06-11": {Open: "26.5000", High: "26.5000", Low: "26.5000", Close: "26.5000", Volume: "3000"},
+		},
+	}
+	splits := &SplitsResponse{Data: []SplitEvent{
+		{EffectiveDate: "2024-06-10", SplitFactor: "4.0000"},
+	}}
+	dividends := &DividendsResponse{Data: []DividendEvent{
+		// Saturday, so it applies from the Friday close backwards
+		{ExDividendDate: "2024-06-08", Amount: "2.00"},
+		// Before the series starts
+		{ExDividendDate: "2024-01-05", Amount: "1.50"},
+	}}
+
+	// Before the split and ex-date: prices / 4 * (1 - 2 / 104), volumes * 4
+	want := map[string]DailyDataPoint{
+		"2024-06-06": {Open: "24.5192", High: "26.9712", Low: "22.0673", Close: "24.5192", Volume: "4000"},
+		"2024-06-07": {Open: "25.5000", High: "25.5000", Low: "25.5000", Close: "25.5000", Volume: "4000"},
+		"2024-06-10": {Open: "26.0000", High: "27.0000", Low: "25.0000", Close: "26.0000", Volume: "4000"},
+		"2024-06-11": {Open: "26.5000", High: "26.5000", Low: "26.5000", Close: "26.5000", Volume: "3000"},
+	}
+
+	adjusted := AdjustDaily(data, splits, dividends)
+	for date, w := range want {
+		if got := adjusted.TimeSeries[date]; got != w {
+			t.Errorf("%s = %+v; want %+v", date, got, w)
+		}
+	}
+
+	// Only the split: the Friday close is exactly a quarter
+	splitOnly := AdjustDaily(data, splits, nil)
+	if got := splitOnly.TimeSeries["2024-06-07"]; got.Close != "26.0000" || got.Volume != "4000" {
+		t.Errorf("split only 2024-06-07 = %+v; want close 26 and volume 4000", got)
+	}
+
+	// The input is left untouched
+	if data.TimeSeries["2024-06-07"].Close != "104.0000" {
+		t.Errorf("AdjustDaily modified its input")
+	}
+	if AdjustDaily(nil, splits, dividends) != nil {
+		t.Errorf("AdjustDaily(nil) != nil")
+	}
+}
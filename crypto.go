@@ -0,0 +1,206 @@
+package alphavintage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// GetDigitalCurrencyDaily returns daily OHLCV data for a digital currency
+// such as BTC, priced in market (e.g. USD). Digital currencies trade every
+// day, so the series has no weekend gaps
+func (c *Client) GetDigitalCurrencyDaily(symbol, market string) (*DigitalCurrencyResponse, error) {
+	return c.GetDigitalCurrencyDailyCtx(context.Background(), symbol, market)
+}
+
+// GetDigitalCurrencyDailyCtx is like GetDigitalCurrencyDaily but aborts when ctx is done
+func (c *Client) GetDigitalCurrencyDailyCtx(ctx context.Context, symbol, market string) (*DigitalCurrencyResponse, error) {
+	params := map[string]string{
+		"function": "DIGITAL_CURRENCY_DAILY",
+		"symbol":   symbol,
+		"market":   market,
+	}
+	return c.getDigitalCurrency(ctx, params, "Time Series (Digital Currency Daily)")
+}
+
+// GetDigitalCurrencyWeekly returns weekly OHLCV data for a digital currency
+func (c *Client) GetDigitalCurrencyWeekly(symbol, market string) (*DigitalCurrencyResponse, error) {
+	return c.GetDigitalCurrencyWeeklyCtx(context.Background(), symbol, market)
+}
+
+// GetDigitalCurrencyWeeklyCtx is like GetDigitalCurrencyWeekly but aborts when ctx is done
+func (c *Client) GetDigitalCurrencyWeeklyCtx(ctx context.Context, symbol, market string) (*DigitalCurrencyResponse, error) {
+	params := map[string]string{
+		"function": "DIGITAL_CURRENCY_WEEKLY",
+		"symbol":   symbol,
+		"market":   market,
+	}
+	return c.getDigitalCurrency(ctx, params, "Time Series (Digital Currency Weekly)")
+}
+
+// GetDigitalCurrencyMonthly returns monthly OHLCV data for a digital currency
+func (c *Client) GetDigitalCurrencyMonthly(symbol, market string) (*DigitalCurrencyResponse, error) {
+	return c.GetDigitalCurrencyMonthlyCtx(context.Background(), symbol, market)
+}
+
+// GetDigitalCurrencyMonthlyCtx is like GetDigitalCurrencyMonthly but aborts when ctx is done
+func (c *Client) GetDigitalCurrencyMonthlyCtx(ctx context.Context, symbol, market string) (*DigitalCurrencyResponse, error) {
+	params := map[string]string{
+		"function": "DIGITAL_CURRENCY_MONTHLY",
+		"symbol":   symbol,
+		"market":   market,
+	}
+	return c.getDigitalCurrency(ctx, params, "Time Series (Digital Currency Monthly)")
+}
+
+// GetCryptoIntraday returns intraday OHLCV data for a digital currency (premium)
+func (c *Client) GetCryptoIntraday(symbol, market string, interval Interval, outputSize OutputSize) (*CryptoIntradayResponse, error) {
+	return c.GetCryptoIntradayCtx(context.Background(), symbol, market, interval, outputSize)
+}
+
+// GetCryptoIntradayCtx is like GetCryptoIntraday but aborts when ctx is done
+func (c *Client) GetCryptoIntradayCtx(ctx context.Context, symbol, market string, interval Interval, outputSize OutputSize) (*CryptoIntradayResponse, error) {
+	params := map[string]string{
+		"function": "CRYPTO_INTRADAY",
+		"symbol":   symbol,
+		"market":   market,
+		"interval": string(interval),
+	}
+	if outputSize != "" {
+		params["outputsize"] = string(outputSize)
+	}
+
+	meta, series, err := c.getRawDigitalCurrency(ctx, params, fmt.Sprintf("Time Series Crypto (%s)", interval))
+	if err != nil {
+		return nil, err
+	}
+
+	result := CryptoIntradayResponse{MetaData: meta, TimeSeries: make(map[string]IntradayDataPoint)}
+	if err := json.Unmarshal(series, &result.TimeSeries); err != nil {
+		return nil, avDecodeError(params, err)
+	}
+	return &result, nil
+}
+
+func (c *Client) getDigitalCurrency(ctx context.Context, params map[string]string, seriesKey string) (*DigitalCurrencyResponse, error) {
+	meta, series, err := c.getRawDigitalCurrency(ctx, params, seriesKey)
+	if err != nil {
+		return nil, err
+	}
+
+	result := DigitalCurrencyResponse{MetaData: meta, TimeSeries: make(map[string]DailyDataPoint)}
+	if err := json.Unmarshal(series, &result.TimeSeries); err != nil {
+		return nil, avDecodeError(params, err)
+	}
+	return &result, nil
+}
+
+func (c *Client) getRawDigitalCurrency(ctx context.Context, params map[string]string, seriesKey string) (DigitalCurrencyMetaData, json.RawMessage, error) {
+	body, err := c.doRequest(ctx, params)
+	if err != nil {
+		return DigitalCurrencyMetaData{}, nil, err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return DigitalCurrencyMetaData{}, nil, avDecodeError(params, err)
+	}
+
+	var meta DigitalCurrencyMetaData
+	if raw["Meta Data"] != nil {
+		var fields map[string]string
+		if err := json.Unmarshal(raw["Meta Data"], &fields); err != nil {
+			return DigitalCurrencyMetaData{}, nil, avDecodeError(params, err)
+		}
+		meta = parseDigitalCurrencyMetaData(fields)
+	}
+	if raw[seriesKey] == nil {
+		return DigitalCurrencyMetaData{}, nil, avMissingSeries(params, seriesKey)
+	}
+	return meta, raw[seriesKey], nil
+}
+
+// parseDigitalCurrencyMetaData reads "Meta Data" by field name, like
+// parseTimeSeriesMetaData
+func parseDigitalCurrencyMetaData(fields map[string]string) DigitalCurrencyMetaData {
+	var meta DigitalCurrencyMetaData
+	for key, value := range fields {
+		if i := strings.Index(key, ". "); i >= 0 {
+			key = key[i+2:]
+		}
+		switch key {
+		case "Information":
+			meta.Information = value
+		case "Digital Currency Code":
+			meta.CurrencyCode = value
+		case "Digital Currency Name":
+			meta.CurrencyName = value
+		case "Market Code":
+			meta.MarketCode = value
+		case "Market Name":
+			meta.MarketName = value
+		case "Last Refreshed":
+			meta.LastRefreshed = value
+		case "Interval":
+			meta.Interval = value
+		case "Output Size":
+			meta.OutputSize = value
+		case "Time Zone":
+			meta.TimeZone = value
+		}
+	}
+	return meta
+}
+
+// symbol names a digital currency series "CODE/MARKET", e.g. "BTC/USD"
+func (m DigitalCurrencyMetaData) symbol() string {
+	return m.CurrencyCode + "/" + m.MarketCode
+}
+
+// ToDaily converts a digital currency series so the daily filters,
+// summaries, chart functions and ReportBuilder accept it. The symbol
+// becomes "CODE/MARKET", e.g. "BTC/USD"
+func (r *DigitalCurrencyResponse) ToDaily() *TimeSeriesDailyResponse {
+	if r == nil {
+		return nil
+	}
+
+	daily := &TimeSeriesDailyResponse{
+		MetaData: TimeSeriesMetaData{
+			Information:   r.MetaData.Information,
+			Symbol:        r.MetaData.symbol(),
+			LastRefreshed: r.MetaData.LastRefreshed,
+			TimeZone:      r.MetaData.TimeZone,
+		},
+		TimeSeries: make(map[string]DailyDataPoint, len(r.TimeSeries)),
+	}
+	for date, p := range r.TimeSeries {
+		daily.TimeSeries[date] = p
+	}
+	return daily
+}
+
+// ToIntraday converts a crypto intraday series so the intraday filters,
+// summaries, chart functions and ReportBuilder accept it
+func (r *CryptoIntradayResponse) ToIntraday() *TimeSeriesIntradayResponse {
+	if r == nil {
+		return nil
+	}
+
+	intraday := &TimeSeriesIntradayResponse{
+		MetaData: IntradayMetaData{
+			Information:   r.MetaData.Information,
+			Symbol:        r.MetaData.symbol(),
+			LastRefreshed: r.MetaData.LastRefreshed,
+			Interval:      r.MetaData.Interval,
+			OutputSize:    r.MetaData.OutputSize,
+			TimeZone:      r.MetaData.TimeZone,
+		},
+		TimeSeries: make(map[string]IntradayDataPoint, len(r.TimeSeries)),
+	}
+	for stamp, p := range r.TimeSeries {
+		intraday.TimeSeries[stamp] = p
+	}
+	return intraday
+}
@@ -0,0 +1,195 @@
+package alphavintage
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+// EconomicIndicator is an Alpha Vantage economic indicator or commodity function
+type EconomicIndicator string
+
+const (
+	IndicatorRealGDP          EconomicIndicator = "REAL_GDP"
+	IndicatorRealGDPPerCapita EconomicIndicator = "REAL_GDP_PER_CAPITA"
+	IndicatorTreasuryYield    EconomicIndicator = "TREASURY_YIELD"
+	IndicatorFederalFundsRate EconomicIndicator = "FEDERAL_FUNDS_RATE"
+	IndicatorCPI              EconomicIndicator = "CPI"
+	IndicatorInflation        EconomicIndicator = "INFLATION"
+	IndicatorRetailSales      EconomicIndicator = "RETAIL_SALES"
+	IndicatorDurables         EconomicIndicator = "DURABLES"
+	IndicatorUnemployment     EconomicIndicator = "UNEMPLOYMENT"
+	IndicatorNonfarmPayroll   EconomicIndicator = "NONFARM_PAYROLL"
+
+	CommodityWTI        EconomicIndicator = "WTI"
+	CommodityBrent      EconomicIndicator = "BRENT"
+	CommodityNaturalGas EconomicIndicator = "NATURAL_GAS"
+	CommodityCopper     EconomicIndicator = "COPPER"
+	CommodityAluminum   EconomicIndicator = "ALUMINUM"
+	CommodityWheat      EconomicIndicator = "WHEAT"
+	CommodityCorn       EconomicIndicator = "CORN"
+	CommodityCotton     EconomicIndicator = "COTTON"
+	CommoditySugar      EconomicIndicator = "SUGAR"
+	CommodityCoffee     EconomicIndicator = "COFFEE"
+	CommodityAll        EconomicIndicator = "ALL_COMMODITIES" // Global price index
+)
+
+// Intervals accepted by economic indicators and commodities. Each function
+// supports only some of them, see the Alpha Vantage documentation
+const (
+	IntervalDaily     Interval = "daily"
+	IntervalWeekly    Interval = "weekly"
+	IntervalMonthly   Interval = "monthly"
+	IntervalQuarterly Interval = "quarterly"
+	IntervalAnnual    Interval = "annual"
+)
+
+// Maturity selects the Treasury bond for TREASURY_YIELD
+type Maturity string
+
+const (
+	Maturity3Month Maturity = "3month"
+	Maturity2Year  Maturity = "2year"
+	Maturity5Year  Maturity = "5year"
+	Maturity7Year  Maturity = "7year"
+	Maturity10Year Maturity = "10year"
+	Maturity30Year Maturity = "30year"
+)
+
+// EconomicOptions contains options for economic indicator and commodity APIs
+type EconomicOptions struct {
+	Interval Interval // Empty = the function's default
+	Maturity Maturity // TREASURY_YIELD only, empty = 10 years
+}
+
+// GetEconomicIndicator returns an economic indicator or commodity price
+// series, e.g. IndicatorCPI or CommodityWTI
+func (c *Client) GetEconomicIndicator(indicator EconomicIndicator, opts *EconomicOptions) (*EconomicSeries, error) {
+	return c.GetEconomicIndicatorCtx(context.Background(), indicator, opts)
+}
+
+// GetEconomicIndicatorCtx is like GetEconomicIndicator but aborts when ctx is done
+func (c *Client) GetEconomicIndicatorCtx(ctx context.Context, indicator EconomicIndicator, opts *EconomicOptions) (*EconomicSeries, error) {
+	params := map[string]string{
+		"function": string(indicator),
+	}
+
+	if opts != nil {
+		if opts.Interval != "" {
+			params["interval"] = string(opts.Interval)
+		}
+		if opts.Maturity != "" {
+			params["maturity"] = string(opts.Maturity)
+		}
+	}
+
+	body, err := c.doRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result EconomicSeries
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, avDecodeError(params, err)
+	}
+
+	if len(result.Data) == 0 {
+		return nil, &APIError{
+			Kind:     ErrAPI,
+			Provider: ProviderAlphaVantage,
+			Function: params["function"],
+			Message:  "no data returned",
+		}
+	}
+
+	return &result, nil
+}
+
+// GetTreasuryYield returns the yield of the Treasury bond with the given maturity
+func (c *Client) GetTreasuryYield(interval Interval, maturity Maturity) (*EconomicSeries, error) {
+	return c.GetTreasuryYieldCtx(context.Background(), interval, maturity)
+}
+
+// GetTreasuryYieldCtx is like GetTreasuryYield but aborts when ctx is done
+func (c *Client) GetTreasuryYieldCtx(ctx context.Context, interval Interval, maturity Maturity) (*EconomicSeries, error) {
+	return c.GetEconomicIndicatorCtx(ctx, IndicatorTreasuryYield, &EconomicOptions{Interval: interval, Maturity: maturity})
+}
+
+// GetCommodity returns the price series of a commodity, e.g. CommodityBrent
+func (c *Client) GetCommodity(commodity EconomicIndicator, interval Interval) (*EconomicSeries, error) {
+	return c.GetCommodityCtx(context.Background(), commodity, interval)
+}
+
+// GetCommodityCtx is like GetCommodity but aborts when ctx is done
+func (c *Client) GetCommodityCtx(ctx context.Context, commodity EconomicIndicator, interval Interval) (*EconomicSeries, error) {
+	return c.GetEconomicIndicatorCtx(ctx, commodity, &EconomicOptions{Interval: interval})
+}
+
+// Values returns the observations oldest first, skipping dates without a value
+func (s *EconomicSeries) Values() ([]time.Time, []float64) {
+	if s == nil {
+		return nil, nil
+	}
+
+	type point struct {
+		date  time.Time
+		value float64
+	}
+	var points []point
+	for _, d := range s.Data {
+		t, err := time.Parse("2006-01-02", d.Date)
+		if err != nil {
+			continue
+		}
+		v, err := parseFloat(d.Value)
+		if err != nil {
+			continue
+		}
+		points = append(points, point{t, v})
+	}
+
+	sort.Slice(points, func(i, j int) bool {
+		return points[i].date.Before(points[j].date)
+	})
+
+	dates := make([]time.Time, len(points))
+	values := make([]float64, len(points))
+	for i, p := range points {
+		dates[i], values[i] = p.date, p.value
+	}
+	return dates, values
+}
+
+// Latest returns the newest observation that has a value
+func (s *EconomicSeries) Latest() (EconomicDataPoint, bool) {
+	var latest EconomicDataPoint
+	found := false
+	if s == nil {
+		return latest, found
+	}
+	for _, d := range s.Data {
+		if _, err := parseFloat(d.Value); err != nil {
+			continue
+		}
+		if !found || d.Date > latest.Date {
+			latest, found = d, true
+		}
+	}
+	return latest, found
+}
+
+// Since returns a copy of the series with only the observations on or after
+// date (YYYY-MM-DD)
+func (s *EconomicSeries) Since(date string) *EconomicSeries {
+	if s == nil {
+		return nil
+	}
+	filtered := &EconomicSeries{Name: s.Name, Interval: s.Interval, Unit: s.Unit}
+	for _, d := range s.Data {
+		if d.Date >= date {
+			filtered.Data = append(filtered.Data, d)
+		}
+	}
+	return filtered
+}
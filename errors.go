@@ -0,0 +1,163 @@
+package alphavintage
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Provider names used in error values
+const (
+	ProviderAlphaVantage      = "alphavantage"
+	ProviderFinancialDatasets = "financialdatasets"
+	ProviderOpenRouter        = "openrouter"
+)
+
+// Error kinds, match with errors.Is
+var (
+	ErrInvalidSymbol   = errors.New("invalid symbol")
+	ErrRateLimited     = errors.New("rate limited")
+	ErrPremiumRequired = errors.New("premium endpoint required")
+	ErrInvalidAPIKey   = errors.New("invalid API key")
+	ErrAPI             = errors.New("API error") // Anything the API reported that isn't classified above
+)
+
+// APIError is returned when a provider answers with an error payload
+// (Alpha Vantage "Error Message", "Note" or "Information", OpenRouter "error")
+type APIError struct {
+	Kind     error  // One of the Err* kinds above
+	Provider string // ProviderAlphaVantage, ProviderFinancialDatasets or ProviderOpenRouter
+	Function string // Alpha Vantage function, Financial Datasets endpoint or AI model
+	Symbol   string // Symbol or ticker of the request, if any
+	Message  string // Raw message from the provider
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s: %v: %s", describeRequest(e.Provider, e.Function, e.Symbol), e.Kind, e.Message)
+}
+
+// Unwrap exposes Kind to errors.Is
+func (e *APIError) Unwrap() error {
+	return e.Kind
+}
+
+// HTTPError is returned when a provider answers with a non-200 status
+type HTTPError struct {
+	Provider   string
+	Function   string
+	Symbol     string
+	StatusCode int
+	Message    string // Error message from the body, if it had one
+}
+
+func (e *HTTPError) Error() string {
+	msg := fmt.Sprintf("%s: unexpected status code: %d", describeRequest(e.Provider, e.Function, e.Symbol), e.StatusCode)
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	return msg
+}
+
+// Unwrap maps well-known status codes onto the Err* kinds
+func (e *HTTPError) Unwrap() error {
+	return statusKind(e.StatusCode, e.Symbol)
+}
+
+func statusKind(statusCode int, symbol string) error {
+	switch statusCode {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrInvalidAPIKey
+	case http.StatusPaymentRequired:
+		return ErrPremiumRequired
+	case http.StatusTooManyRequests:
+		return ErrRateLimited
+	case http.StatusNotFound:
+		if symbol != "" {
+			return ErrInvalidSymbol
+		}
+	}
+	return nil
+}
+
+// DecodeError is returned when a response body can't be parsed
+type DecodeError struct {
+	Provider string
+	Function string
+	Symbol   string
+	Err      error
+}
+
+func (e *DecodeError) Error() string {
+	return fmt.Sprintf("%s: decode response: %v", describeRequest(e.Provider, e.Function, e.Symbol), e.Err)
+}
+
+func (e *DecodeError) Unwrap() error {
+	return e.Err
+}
+
+func describeRequest(provider, function, symbol string) string {
+	s := provider
+	if function != "" {
+		s += " " + function
+	}
+	if symbol != "" {
+		s += " (" + symbol + ")"
+	}
+	return s
+}
+
+// classifyAVMessage decides the error kind for an Alpha Vantage payload.
+// field is the JSON key the message came in: "Error Message", "Note" or "Information"
+func classifyAVMessage(field, message, symbol string) error {
+	lower := strings.ToLower(message)
+
+	// Order matters: rate limit messages also point at the premium plans
+	switch {
+	case (strings.Contains(lower, "apikey") || strings.Contains(lower, "api key")) &&
+		(strings.Contains(lower, "invalid") || strings.Contains(lower, "missing")):
+		return ErrInvalidAPIKey
+	case field == "Note",
+		strings.Contains(lower, "rate limit"),
+		strings.Contains(lower, "call frequency"),
+		strings.Contains(lower, "requests per day"),
+		strings.Contains(lower, "more sparingly"):
+		return ErrRateLimited
+	case strings.Contains(lower, "premium"):
+		return ErrPremiumRequired
+	case field == "Error Message" && symbol != "":
+		// "Invalid API call" is what Alpha Vantage answers for unknown symbols
+		return ErrInvalidSymbol
+	}
+	return ErrAPI
+}
+
+func avDecodeError(params map[string]string, err error) error {
+	return &DecodeError{
+		Provider: ProviderAlphaVantage,
+		Function: params["function"],
+		Symbol:   params["symbol"],
+		Err:      err,
+	}
+}
+
+// avMissingSeries reports an answer without the expected time series, e.g.
+// when Alpha Vantage returns only "Meta Data"
+func avMissingSeries(params map[string]string, seriesKey string) error {
+	return &APIError{
+		Kind:     ErrAPI,
+		Provider: ProviderAlphaVantage,
+		Function: params["function"],
+		Symbol:   params["symbol"],
+		Message:  fmt.Sprintf("no %q returned", seriesKey),
+	}
+}
+
+func fdDecodeError(endpoint string, params map[string]string, err error) error {
+	return &DecodeError{
+		Provider: ProviderFinancialDatasets,
+		Function: endpoint,
+		Symbol:   params["ticker"],
+		Err:      err,
+	}
+}
@@ -21,6 +21,24 @@ func main() {
 	}
 	fdKey := "4959d8c9-f9c4-4633-bb02-4c98d0f8923f"
 
+	// Record every API response with FIXTURES_DIR=testdata FIXTURES_MODE=record,
+	// then replay offline with just FIXTURES_DIR=testdata. FIXTURES_AS_OF pins
+	// "today" so replayed runs ask for the same date ranges as the recording
+	var clientOpts []alphavintage.Option
+	replaying := false
+	now := time.Now()
+	if dir := os.Getenv("FIXTURES_DIR"); dir != "" {
+		mode := alphavintage.FixtureReplay
+		if os.Getenv("FIXTURES_MODE") == "record" {
+			mode = alphavintage.FixtureRecord
+		}
+		replaying = mode == alphavintage.FixtureReplay
+		clientOpts = append(clientOpts, alphavintage.WithFixtures(dir, mode))
+		if asOf, err := time.Parse("2006-01-02", os.Getenv("FIXTURES_AS_OF")); err == nil {
+			now = asOf
+		}
+	}
+
 	symbol := "AAPL"
 	fmt.Printf("=== Alpha Vantage Go Library - Full Test ===\n\n")
 
@@ -28,7 +46,11 @@ func main() {
 	// PART 1: Alpha Vantage API
 	// ==========================================
 	fmt.Println("--- PART 1: Alpha Vantage API ---")
-	client := alphavintage.NewClient(avKey)
+	client := alphavintage.NewClient(avKey, clientOpts...)
+	if !replaying {
+		// Stay under the free tier quota instead of sleeping between calls
+		client.WithRateLimit(alphavintage.FreeTierRateLimit())
+	}
 
 	// Daily prices
 	fmt.Printf("Fetching daily prices for %s...\n", symbol)
@@ -38,7 +60,6 @@ func main() {
 	} else {
 		fmt.Printf("  Daily: %d data points\n", len(daily.TimeSeries))
 	}
-	time.Sleep(12 * time.Second)
 
 	// Earnings
 	fmt.Println("Fetching earnings...")
@@ -49,7 +70,6 @@ func main() {
 		fmt.Printf("  Annual earnings: %d records\n", len(earnings.AnnualEarnings))
 		fmt.Printf("  Quarterly earnings: %d records\n", len(earnings.QuarterlyEarnings))
 	}
-	time.Sleep(12 * time.Second)
 
 	// Cash Flow
 	fmt.Println("Fetching cash flow...")
@@ -59,7 +79,6 @@ func main() {
 	} else {
 		fmt.Printf("  Annual reports: %d\n", len(cashflow.AnnualReports))
 	}
-	time.Sleep(12 * time.Second)
 
 	// Balance Sheet
 	fmt.Println("Fetching balance sheet...")
@@ -69,7 +88,6 @@ func main() {
 	} else {
 		fmt.Printf("  Annual reports: %d\n", len(balance.AnnualReports))
 	}
-	time.Sleep(12 * time.Second)
 
 	// Market Status
 	fmt.Println("Fetching market status...")
@@ -79,7 +97,6 @@ func main() {
 	} else {
 		fmt.Printf("  Markets: %d\n", len(market.Markets))
 	}
-	time.Sleep(12 * time.Second)
 
 	// News Sentiment
 	fmt.Println("Fetching news sentiment...")
@@ -152,7 +169,7 @@ func main() {
 			APIKey:    orKey,
 			Model:     "nvidia/nemotron-3-nano-30b-a3b:free",
 			Reasoning: false,
-		})
+		}, clientOpts...)
 
 		stockData := alphavintage.StockAnalysisData{
 			Symbol:       symbol,
@@ -215,7 +232,7 @@ func main() {
 	var fdNews []alphavintage.FDNews
 
 	if fdKey != "" {
-		fd := alphavintage.NewFinancialDatasetsClient(fdKey)
+		fd := alphavintage.NewFinancialDatasetsClient(fdKey, clientOpts...)
 
 		// Company Facts
 		fmt.Println("Fetching company facts...")
@@ -237,8 +254,8 @@ func main() {
 
 		// Historical Prices
 		fmt.Println("Fetching historical prices...")
-		endDate := time.Now().Format("2006-01-02")
-		startDate := time.Now().AddDate(0, -3, 0).Format("2006-01-02")
+		endDate := now.Format("2006-01-02")
+		startDate := now.AddDate(0, -3, 0).Format("2006-01-02")
 		fdPrices, err = fd.GetPrices(symbol, alphavintage.FDIntervalDay, 1, startDate, endDate, 100)
 		if err != nil {
 			log.Printf("FD prices error: %v", err)
@@ -1,6 +1,7 @@
 package alphavintage
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -12,25 +13,45 @@ const fdBaseURL = "https://api.financialdatasets.ai"
 
 // FinancialDatasetsClient handles Financial Datasets API
 type FinancialDatasetsClient struct {
-	apiKey string
-	resty  *resty.Client
+	apiKey  string
+	baseURL string
+	resty   *resty.Client
+	retry   *RetryPolicy
 }
 
 // NewFinancialDatasetsClient creates a new Financial Datasets API client
-func NewFinancialDatasetsClient(apiKey string) *FinancialDatasetsClient {
+func NewFinancialDatasetsClient(apiKey string, opts ...Option) *FinancialDatasetsClient {
+	cfg := newHTTPConfig(fdBaseURL, opts)
 	return &FinancialDatasetsClient{
-		apiKey: apiKey,
-		resty:  resty.New().SetTimeout(30 * time.Second),
+		apiKey:  apiKey,
+		baseURL: cfg.baseURL,
+		resty:   cfg.newResty(30 * time.Second),
 	}
 }
 
-func (c *FinancialDatasetsClient) doRequest(endpoint string, params map[string]string) ([]byte, error) {
+// WithRetry retries rate-limited and transient failures according to policy
+func (c *FinancialDatasetsClient) WithRetry(policy RetryPolicy) *FinancialDatasetsClient {
+	c.retry = &policy
+	return c
+}
+
+func (c *FinancialDatasetsClient) doRequest(ctx context.Context, endpoint string, params map[string]string) ([]byte, error) {
+	return c.retry.do(ctx, func() ([]byte, error) {
+		return c.doRequestOnce(ctx, endpoint, params)
+	})
+}
+
+func (c *FinancialDatasetsClient) doRequestOnce(ctx context.Context, endpoint string, params map[string]string) ([]byte, error) {
 	resp, err := c.resty.R().
+		SetContext(ctx).
 		SetHeader("X-API-KEY", c.apiKey).
 		SetQueryParams(params).
-		Get(fdBaseURL + endpoint)
+		Get(c.baseURL + endpoint)
 
 	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, ctxErr
+		}
 		return nil, fmt.Errorf("request failed: %w", err)
 	}
 
@@ -40,10 +61,17 @@ func (c *FinancialDatasetsClient) doRequest(endpoint string, params map[string]s
 			Message string `json:"message"`
 		}
 		json.Unmarshal(resp.Body(), &errResp)
-		if errResp.Message != "" {
-			return nil, fmt.Errorf("API error: %s", errResp.Message)
+		message := errResp.Message
+		if message == "" {
+			message = errResp.Error
+		}
+		return nil, &HTTPError{
+			Provider:   ProviderFinancialDatasets,
+			Function:   endpoint,
+			Symbol:     params["ticker"],
+			StatusCode: resp.StatusCode(),
+			Message:    message,
 		}
-		return nil, fmt.Errorf("API error: status %d", resp.StatusCode())
 	}
 
 	return resp.Body(), nil
@@ -236,6 +264,11 @@ type FDFinancialMetrics struct {
 
 // GetIncomeStatements returns income statements for a ticker
 func (c *FinancialDatasetsClient) GetIncomeStatements(ticker string, period FDPeriod, limit int) ([]FDIncomeStatement, error) {
+	return c.GetIncomeStatementsCtx(context.Background(), ticker, period, limit)
+}
+
+// GetIncomeStatementsCtx is like GetIncomeStatements but aborts when ctx is done
+func (c *FinancialDatasetsClient) GetIncomeStatementsCtx(ctx context.Context, ticker string, period FDPeriod, limit int) ([]FDIncomeStatement, error) {
 	params := map[string]string{
 		"ticker": ticker,
 		"period": string(period),
@@ -244,7 +277,7 @@ func (c *FinancialDatasetsClient) GetIncomeStatements(ticker string, period FDPe
 		params["limit"] = fmt.Sprintf("%d", limit)
 	}
 
-	body, err := c.doRequest("/financials/income-statements", params)
+	body, err := c.doRequest(ctx, "/financials/income-statements", params)
 	if err != nil {
 		return nil, err
 	}
@@ -253,13 +286,18 @@ func (c *FinancialDatasetsClient) GetIncomeStatements(ticker string, period FDPe
 		IncomeStatements []FDIncomeStatement `json:"income_statements"`
 	}
 	if err := json.Unmarshal(body, &resp); err != nil {
-		return nil, err
+		return nil, fdDecodeError("/financials/income-statements", params, err)
 	}
 	return resp.IncomeStatements, nil
 }
 
 // GetBalanceSheets returns balance sheets for a ticker
 func (c *FinancialDatasetsClient) GetBalanceSheets(ticker string, period FDPeriod, limit int) ([]FDBalanceSheet, error) {
+	return c.GetBalanceSheetsCtx(context.Background(), ticker, period, limit)
+}
+
+// GetBalanceSheetsCtx is like GetBalanceSheets but aborts when ctx is done
+func (c *FinancialDatasetsClient) GetBalanceSheetsCtx(ctx context.Context, ticker string, period FDPeriod, limit int) ([]FDBalanceSheet, error) {
 	params := map[string]string{
 		"ticker": ticker,
 		"period": string(period),
@@ -268,7 +306,7 @@ func (c *FinancialDatasetsClient) GetBalanceSheets(ticker string, period FDPerio
 		params["limit"] = fmt.Sprintf("%d", limit)
 	}
 
-	body, err := c.doRequest("/financials/balance-sheets", params)
+	body, err := c.doRequest(ctx, "/financials/balance-sheets", params)
 	if err != nil {
 		return nil, err
 	}
@@ -277,13 +315,18 @@ func (c *FinancialDatasetsClient) GetBalanceSheets(ticker string, period FDPerio
 		BalanceSheets []FDBalanceSheet `json:"balance_sheets"`
 	}
 	if err := json.Unmarshal(body, &resp); err != nil {
-		return nil, err
+		return nil, fdDecodeError("/financials/balance-sheets", params, err)
 	}
 	return resp.BalanceSheets, nil
 }
 
 // GetCashFlowStatements returns cash flow statements for a ticker
 func (c *FinancialDatasetsClient) GetCashFlowStatements(ticker string, period FDPeriod, limit int) ([]FDCashFlowStatement, error) {
+	return c.GetCashFlowStatementsCtx(context.Background(), ticker, period, limit)
+}
+
+// GetCashFlowStatementsCtx is like GetCashFlowStatements but aborts when ctx is done
+func (c *FinancialDatasetsClient) GetCashFlowStatementsCtx(ctx context.Context, ticker string, period FDPeriod, limit int) ([]FDCashFlowStatement, error) {
 	params := map[string]string{
 		"ticker": ticker,
 		"period": string(period),
@@ -292,7 +335,7 @@ func (c *FinancialDatasetsClient) GetCashFlowStatements(ticker string, period FD
 		params["limit"] = fmt.Sprintf("%d", limit)
 	}
 
-	body, err := c.doRequest("/financials/cash-flow-statements", params)
+	body, err := c.doRequest(ctx, "/financials/cash-flow-statements", params)
 	if err != nil {
 		return nil, err
 	}
@@ -301,16 +344,21 @@ func (c *FinancialDatasetsClient) GetCashFlowStatements(ticker string, period FD
 		CashFlowStatements []FDCashFlowStatement `json:"cash_flow_statements"`
 	}
 	if err := json.Unmarshal(body, &resp); err != nil {
-		return nil, err
+		return nil, fdDecodeError("/financials/cash-flow-statements", params, err)
 	}
 	return resp.CashFlowStatements, nil
 }
 
 // GetCompanyFacts returns company information
 func (c *FinancialDatasetsClient) GetCompanyFacts(ticker string) (*FDCompanyFacts, error) {
+	return c.GetCompanyFactsCtx(context.Background(), ticker)
+}
+
+// GetCompanyFactsCtx is like GetCompanyFacts but aborts when ctx is done
+func (c *FinancialDatasetsClient) GetCompanyFactsCtx(ctx context.Context, ticker string) (*FDCompanyFacts, error) {
 	params := map[string]string{"ticker": ticker}
 
-	body, err := c.doRequest("/company/facts", params)
+	body, err := c.doRequest(ctx, "/company/facts", params)
 	if err != nil {
 		return nil, err
 	}
@@ -319,16 +367,21 @@ func (c *FinancialDatasetsClient) GetCompanyFacts(ticker string) (*FDCompanyFact
 		CompanyFacts FDCompanyFacts `json:"company_facts"`
 	}
 	if err := json.Unmarshal(body, &resp); err != nil {
-		return nil, err
+		return nil, fdDecodeError("/company/facts", params, err)
 	}
 	return &resp.CompanyFacts, nil
 }
 
 // GetPriceSnapshot returns real-time price
 func (c *FinancialDatasetsClient) GetPriceSnapshot(ticker string) (*FDPriceSnapshot, error) {
+	return c.GetPriceSnapshotCtx(context.Background(), ticker)
+}
+
+// GetPriceSnapshotCtx is like GetPriceSnapshot but aborts when ctx is done
+func (c *FinancialDatasetsClient) GetPriceSnapshotCtx(ctx context.Context, ticker string) (*FDPriceSnapshot, error) {
 	params := map[string]string{"ticker": ticker}
 
-	body, err := c.doRequest("/prices/snapshot", params)
+	body, err := c.doRequest(ctx, "/prices/snapshot", params)
 	if err != nil {
 		return nil, err
 	}
@@ -337,13 +390,18 @@ func (c *FinancialDatasetsClient) GetPriceSnapshot(ticker string) (*FDPriceSnaps
 		Snapshot FDPriceSnapshot `json:"snapshot"`
 	}
 	if err := json.Unmarshal(body, &resp); err != nil {
-		return nil, err
+		return nil, fdDecodeError("/prices/snapshot", params, err)
 	}
 	return &resp.Snapshot, nil
 }
 
 // GetPrices returns historical price data
 func (c *FinancialDatasetsClient) GetPrices(ticker string, interval FDInterval, multiplier int, startDate, endDate string, limit int) ([]FDPrice, error) {
+	return c.GetPricesCtx(context.Background(), ticker, interval, multiplier, startDate, endDate, limit)
+}
+
+// GetPricesCtx is like GetPrices but aborts when ctx is done
+func (c *FinancialDatasetsClient) GetPricesCtx(ctx context.Context, ticker string, interval FDInterval, multiplier int, startDate, endDate string, limit int) ([]FDPrice, error) {
 	params := map[string]string{
 		"ticker":              ticker,
 		"interval":            string(interval),
@@ -355,7 +413,7 @@ func (c *FinancialDatasetsClient) GetPrices(ticker string, interval FDInterval,
 		params["limit"] = fmt.Sprintf("%d", limit)
 	}
 
-	body, err := c.doRequest("/prices", params)
+	body, err := c.doRequest(ctx, "/prices", params)
 	if err != nil {
 		return nil, err
 	}
@@ -364,19 +422,24 @@ func (c *FinancialDatasetsClient) GetPrices(ticker string, interval FDInterval,
 		Prices []FDPrice `json:"prices"`
 	}
 	if err := json.Unmarshal(body, &resp); err != nil {
-		return nil, err
+		return nil, fdDecodeError("/prices", params, err)
 	}
 	return resp.Prices, nil
 }
 
 // GetInsiderTrades returns insider trading data
 func (c *FinancialDatasetsClient) GetInsiderTrades(ticker string, limit int) ([]FDInsiderTrade, error) {
+	return c.GetInsiderTradesCtx(context.Background(), ticker, limit)
+}
+
+// GetInsiderTradesCtx is like GetInsiderTrades but aborts when ctx is done
+func (c *FinancialDatasetsClient) GetInsiderTradesCtx(ctx context.Context, ticker string, limit int) ([]FDInsiderTrade, error) {
 	params := map[string]string{"ticker": ticker}
 	if limit > 0 {
 		params["limit"] = fmt.Sprintf("%d", limit)
 	}
 
-	body, err := c.doRequest("/insider-trades", params)
+	body, err := c.doRequest(ctx, "/insider-trades", params)
 	if err != nil {
 		return nil, err
 	}
@@ -385,19 +448,24 @@ func (c *FinancialDatasetsClient) GetInsiderTrades(ticker string, limit int) ([]
 		InsiderTrades []FDInsiderTrade `json:"insider_trades"`
 	}
 	if err := json.Unmarshal(body, &resp); err != nil {
-		return nil, err
+		return nil, fdDecodeError("/insider-trades", params, err)
 	}
 	return resp.InsiderTrades, nil
 }
 
 // GetInstitutionalOwnership returns institutional holdings
 func (c *FinancialDatasetsClient) GetInstitutionalOwnership(ticker string, limit int) ([]FDInstitutionalOwnership, error) {
+	return c.GetInstitutionalOwnershipCtx(context.Background(), ticker, limit)
+}
+
+// GetInstitutionalOwnershipCtx is like GetInstitutionalOwnership but aborts when ctx is done
+func (c *FinancialDatasetsClient) GetInstitutionalOwnershipCtx(ctx context.Context, ticker string, limit int) ([]FDInstitutionalOwnership, error) {
 	params := map[string]string{"ticker": ticker}
 	if limit > 0 {
 		params["limit"] = fmt.Sprintf("%d", limit)
 	}
 
-	body, err := c.doRequest("/institutional-ownership", params)
+	body, err := c.doRequest(ctx, "/institutional-ownership", params)
 	if err != nil {
 		return nil, err
 	}
@@ -406,13 +474,18 @@ func (c *FinancialDatasetsClient) GetInstitutionalOwnership(ticker string, limit
 		Ownership []FDInstitutionalOwnership `json:"institutional-ownership"`
 	}
 	if err := json.Unmarshal(body, &resp); err != nil {
-		return nil, err
+		return nil, fdDecodeError("/institutional-ownership", params, err)
 	}
 	return resp.Ownership, nil
 }
 
 // GetNews returns news articles
 func (c *FinancialDatasetsClient) GetNews(ticker string, startDate, endDate string, limit int) ([]FDNews, error) {
+	return c.GetNewsCtx(context.Background(), ticker, startDate, endDate, limit)
+}
+
+// GetNewsCtx is like GetNews but aborts when ctx is done
+func (c *FinancialDatasetsClient) GetNewsCtx(ctx context.Context, ticker string, startDate, endDate string, limit int) ([]FDNews, error) {
 	params := map[string]string{"ticker": ticker}
 	if startDate != "" {
 		params["start_date"] = startDate
@@ -424,7 +497,7 @@ func (c *FinancialDatasetsClient) GetNews(ticker string, startDate, endDate stri
 		params["limit"] = fmt.Sprintf("%d", limit)
 	}
 
-	body, err := c.doRequest("/news", params)
+	body, err := c.doRequest(ctx, "/news", params)
 	if err != nil {
 		return nil, err
 	}
@@ -433,13 +506,18 @@ func (c *FinancialDatasetsClient) GetNews(ticker string, startDate, endDate stri
 		News []FDNews `json:"news"`
 	}
 	if err := json.Unmarshal(body, &resp); err != nil {
-		return nil, err
+		return nil, fdDecodeError("/news", params, err)
 	}
 	return resp.News, nil
 }
 
 // GetFinancialMetrics returns financial ratios and metrics
 func (c *FinancialDatasetsClient) GetFinancialMetrics(ticker string, period FDPeriod, limit int) ([]FDFinancialMetrics, error) {
+	return c.GetFinancialMetricsCtx(context.Background(), ticker, period, limit)
+}
+
+// GetFinancialMetricsCtx is like GetFinancialMetrics but aborts when ctx is done
+func (c *FinancialDatasetsClient) GetFinancialMetricsCtx(ctx context.Context, ticker string, period FDPeriod, limit int) ([]FDFinancialMetrics, error) {
 	params := map[string]string{
 		"ticker": ticker,
 		"period": string(period),
@@ -448,7 +526,7 @@ func (c *FinancialDatasetsClient) GetFinancialMetrics(ticker string, period FDPe
 		params["limit"] = fmt.Sprintf("%d", limit)
 	}
 
-	body, err := c.doRequest("/financial-metrics", params)
+	body, err := c.doRequest(ctx, "/financial-metrics", params)
 	if err != nil {
 		return nil, err
 	}
@@ -457,23 +535,28 @@ func (c *FinancialDatasetsClient) GetFinancialMetrics(ticker string, period FDPe
 		Metrics []FDFinancialMetrics `json:"financial_metrics"`
 	}
 	if err := json.Unmarshal(body, &resp); err != nil {
-		return nil, err
+		return nil, fdDecodeError("/financial-metrics", params, err)
 	}
 	return resp.Metrics, nil
 }
 
 // GetFinancialMetricsSnapshot returns current financial metrics
 func (c *FinancialDatasetsClient) GetFinancialMetricsSnapshot(ticker string) (*FDFinancialMetrics, error) {
+	return c.GetFinancialMetricsSnapshotCtx(context.Background(), ticker)
+}
+
+// GetFinancialMetricsSnapshotCtx is like GetFinancialMetricsSnapshot but aborts when ctx is done
+func (c *FinancialDatasetsClient) GetFinancialMetricsSnapshotCtx(ctx context.Context, ticker string) (*FDFinancialMetrics, error) {
 	params := map[string]string{"ticker": ticker}
 
-	body, err := c.doRequest("/financial-metrics/snapshot", params)
+	body, err := c.doRequest(ctx, "/financial-metrics/snapshot", params)
 	if err != nil {
 		return nil, err
 	}
 
 	var resp FDFinancialMetrics
 	if err := json.Unmarshal(body, &resp); err != nil {
-		return nil, err
+		return nil, fdDecodeError("/financial-metrics/snapshot", params, err)
 	}
 	return &resp, nil
 }
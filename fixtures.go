@@ -0,0 +1,234 @@
+package alphavintage
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// FixtureMode selects whether WithFixtures records or replays responses
+type FixtureMode int
+
+const (
+	// FixtureReplay serves responses from the fixture directory and never
+	// touches the network
+	FixtureReplay FixtureMode = iota
+	// FixtureRecord sends requests to the API and saves every response
+	FixtureRecord
+)
+
+// ErrFixtureMissing is returned in replay mode when no fixture matches a request
+var ErrFixtureMissing = errors.New("fixture not found")
+
+// Query params and headers that carry credentials. They are never written
+// to fixtures and don't take part in matching
+var (
+	scrubbedParams  = []string{"apikey"}
+	scrubbedHeaders = []string{"Authorization", "X-API-KEY", "Set-Cookie"}
+)
+
+// WithFixtures records responses to dir or replays them from it, so flows
+// that hit Alpha Vantage, Financial Datasets or OpenRouter can run as
+// deterministic tests. API keys are scrubbed from recorded fixtures
+func WithFixtures(dir string, mode FixtureMode) Option {
+	return func(cfg *httpConfig) {
+		cfg.fixtures = &fixtureTransport{dir: dir, mode: mode}
+	}
+}
+
+type fixtureTransport struct {
+	dir  string
+	mode FixtureMode
+	next http.RoundTripper
+}
+
+type fixture struct {
+	Request struct {
+		Method string `json:"method"`
+		URL    string `json:"url"`
+		Body   string `json:"body,omitempty"`
+	} `json:"request"`
+	Response struct {
+		StatusCode int         `json:"status_code"`
+		Header     http.Header `json:"header"`
+		Body       string      `json:"body"`
+	} `json:"response"`
+}
+
+func (t *fixtureTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	path := t.path(req, body)
+
+	if t.mode == FixtureReplay {
+		return t.replay(req, path)
+	}
+	return t.record(req, path, body)
+}
+
+func (t *fixtureTransport) replay(req *http.Request, path string) (*http.Response, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s %s (expected %s)", ErrFixtureMissing, req.Method, scrubURL(req.URL), path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var f fixture
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("invalid fixture %s: %w", path, err)
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", f.Response.StatusCode, http.StatusText(f.Response.StatusCode)),
+		StatusCode:    f.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        f.Response.Header,
+		Body:          io.NopCloser(strings.NewReader(f.Response.Body)),
+		ContentLength: int64(len(f.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+func (t *fixtureTransport) record(req *http.Request, path string, body []byte) (*http.Response, error) {
+	next := t.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(respBody))
+
+	var f fixture
+	f.Request.Method = req.Method
+	f.Request.URL = scrubURL(req.URL)
+	f.Request.Body = scrubBody(req, string(body))
+	f.Response.StatusCode = resp.StatusCode
+	f.Response.Header = resp.Header.Clone()
+	for _, h := range scrubbedHeaders {
+		f.Response.Header.Del(h)
+	}
+	// Rate limit and invalid key messages can echo the key back
+	f.Response.Body = scrubBody(req, string(respBody))
+
+	if err := writeFixture(t.dir, path, f); err != nil {
+		return nil, fmt.Errorf("recording fixture: %w", err)
+	}
+	return resp, nil
+}
+
+func writeFixture(dir, path string, f fixture) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(f); err != nil {
+		return err
+	}
+	data := buf.Bytes()
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	// Write then rename so a concurrent replay never sees a partial file
+	tmp, err := os.CreateTemp(dir, "tmp-*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(data)
+	tmp.Close()
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+var unsafeName = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// path names a fixture after what the request asks for, e.g.
+// "TIME_SERIES_DAILY-IBM-3f2a9c1d8e7b6a50.json", with a hash of the
+// method, scrubbed path and query, and body so distinct requests never
+// collide. The host isn't part of the hash, so fixtures recorded against
+// the real API replay against a proxy or fake as well
+func (t *fixtureTransport) path(req *http.Request, body []byte) string {
+	clean := *req.URL
+	clean.Scheme, clean.Host = "", ""
+	sum := sha256.Sum256([]byte(req.Method + " " + scrubURL(&clean) + "\n" + string(body)))
+
+	q := req.URL.Query()
+	name := q.Get("function")
+	if name == "" {
+		name = strings.Trim(req.URL.Path, "/")
+	}
+	if symbol := q.Get("symbol"); symbol != "" {
+		name += "-" + symbol
+	} else if ticker := q.Get("ticker"); ticker != "" {
+		name += "-" + ticker
+	}
+	name = strings.Trim(unsafeName.ReplaceAllString(name, "_"), "_")
+
+	return filepath.Join(t.dir, name+"-"+hex.EncodeToString(sum[:8])+".json")
+}
+
+// scrubBody replaces the credentials req was sent with wherever they
+// appear in body. Values shorter than 4 characters are left alone, they
+// would match ordinary text
+func scrubBody(req *http.Request, body string) string {
+	var secrets []string
+	q := req.URL.Query()
+	for _, p := range scrubbedParams {
+		secrets = append(secrets, q.Get(p))
+	}
+	for _, h := range scrubbedHeaders {
+		value := req.Header.Get(h)
+		secrets = append(secrets, value)
+		if token := strings.TrimPrefix(value, "Bearer "); token != value {
+			secrets = append(secrets, token)
+		}
+	}
+	for _, secret := range secrets {
+		if len(secret) >= 4 {
+			body = strings.ReplaceAll(body, secret, "REDACTED")
+		}
+	}
+	return body
+}
+
+// scrubURL returns u without credentials, with query params sorted
+func scrubURL(u *url.URL) string {
+	clean := *u
+	q := clean.Query()
+	for _, p := range scrubbedParams {
+		q.Del(p)
+	}
+	clean.RawQuery = q.Encode()
+	clean.User = nil
+	return clean.String()
+}
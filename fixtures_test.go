@@ -0,0 +1,118 @@
+package alphavintage_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/SwanHtetAungPhyo/alphavintage"
+)
+
+const (
+	avSecret = "AVSECRET0123456789"
+	fdSecret = "FDSECRET0123456789"
+	orSecret = "ORSECRET0123456789"
+)
+
+// echoServer answers like each provider, repeating the credentials back the
+// way Alpha Vantage does in its rate limit messages
+func echoServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-API-KEY", r.Header.Get("X-API-KEY"))
+		switch r.URL.Path {
+		case "/query":
+			fmt.Fprintf(w, `{"Global Quote": {"01. symbol": %q, "05. price": "100.0000"}, "Note": ""}`, r.URL.Query().Get("symbol"))
+		case "/limited":
+			fmt.Fprintf(w, `{"Information": "We have detected your API key as %s and our standard API rate limit is 25 requests per day."}`, r.URL.Query().Get("apikey"))
+		case "/financials/income-statements":
+			fmt.Fprintf(w, `{"income_statements": [], "key": %q}`, r.Header.Get("X-API-KEY"))
+		case "/chat":
+			fmt.Fprintf(w, `{"choices": [{"message": {"content": %q}}]}`, "seen "+r.Header.Get("Authorization"))
+		}
+	}))
+}
+
+func TestFixturesRecordScrubsKeys(t *testing.T) {
+	srv := echoServer()
+	defer srv.Close()
+	dir := t.TempDir()
+	record := alphavintage.WithFixtures(dir, alphavintage.FixtureRecord)
+
+	av := alphavintage.NewClient(avSecret, alphavintage.WithBaseURL(srv.URL+"/query"), record)
+	if _, err := av.GetQuote("IBM"); err != nil {
+		t.Fatal(err)
+	}
+	limited := alphavintage.NewClient(avSecret, alphavintage.WithBaseURL(srv.URL+"/limited"), record)
+	if _, err := limited.GetQuote("MSFT"); !errors.Is(err, alphavintage.ErrRateLimited) {
+		t.Fatalf("err = %v; want ErrRateLimited", err)
+	}
+	fd := alphavintage.NewFinancialDatasetsClient(fdSecret, alphavintage.WithBaseURL(srv.URL), record)
+	if _, err := fd.GetIncomeStatements("IBM", alphavintage.FDPeriodAnnual, 1); err != nil {
+		t.Fatal(err)
+	}
+	ai := alphavintage.NewAIClient(alphavintage.AIConfig{APIKey: orSecret}, alphavintage.WithBaseURL(srv.URL+"/chat"), record)
+	if _, err := ai.CustomAnalysis(alphavintage.StockAnalysisData{Symbol: "IBM"}, "hi"); err != nil {
+		t.Fatal(err)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.json"))
+	if len(files) != 4 {
+		t.Fatalf("recorded %d fixtures; want 4", len(files))
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, secret := range []string{avSecret, fdSecret, orSecret} {
+			if strings.Contains(string(data), secret) {
+				t.Errorf("%s contains an API key:\n%s", filepath.Base(file), data)
+			}
+		}
+	}
+}
+
+func TestFixturesReplayOffline(t *testing.T) {
+	srv := echoServer()
+	dir := t.TempDir()
+
+	recorder := alphavintage.NewClient(avSecret, alphavintage.WithBaseURL(srv.URL+"/query"),
+		alphavintage.WithFixtures(dir, alphavintage.FixtureRecord))
+	recorded, err := recorder.GetQuote("IBM")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.Close()
+
+	// Replay matches without the key, so a different one works too
+	replayer := alphavintage.NewClient("another-key", alphavintage.WithBaseURL(srv.URL+"/query"),
+		alphavintage.WithFixtures(dir, alphavintage.FixtureReplay))
+	replayed, err := replayer.GetQuote("IBM")
+	if err != nil {
+		t.Fatalf("replay with the server gone: %v", err)
+	}
+	if *replayed != *recorded {
+		t.Fatalf("replayed %+v; want %+v", replayed, recorded)
+	}
+}
+
+func TestFixturesMissing(t *testing.T) {
+	client := alphavintage.NewClient(avSecret, alphavintage.WithFixtures(t.TempDir(), alphavintage.FixtureReplay)).
+		WithRetry(alphavintage.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Hour})
+
+	// A retry would sleep past the deadline and report that instead
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_, err := client.GetQuoteCtx(ctx, "IBM")
+	if !errors.Is(err, alphavintage.ErrFixtureMissing) {
+		t.Fatalf("err = %v; want ErrFixtureMissing without retries", err)
+	}
+}
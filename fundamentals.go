@@ -1,42 +1,141 @@
 package alphavintage
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+)
+
+// GetCompanyOverview returns the company profile, valuation ratios and
+// analyst target for a symbol
+func (c *Client) GetCompanyOverview(symbol string) (*CompanyOverview, error) {
+	return c.GetCompanyOverviewCtx(context.Background(), symbol)
+}
+
+// GetCompanyOverviewCtx is like GetCompanyOverview but aborts when ctx is done
+func (c *Client) GetCompanyOverviewCtx(ctx context.Context, symbol string) (*CompanyOverview, error) {
+	params := map[string]string{
+		"function": "OVERVIEW",
+		"symbol":   symbol,
+	}
+
+	body, err := c.doRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result CompanyOverview
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, avDecodeError(params, err)
+	}
+
+	// Unknown symbols get an empty object instead of an error
+	if result.Symbol == "" {
+		return nil, &APIError{
+			Kind:     ErrInvalidSymbol,
+			Provider: ProviderAlphaVantage,
+			Function: params["function"],
+			Symbol:   symbol,
+			Message:  "no overview returned",
+		}
+	}
+
+	return &result, nil
+}
 
 // GetBalanceSheet returns balance sheet data for a symbol
 func (c *Client) GetBalanceSheet(symbol string) (*BalanceSheetResponse, error) {
+	return c.GetBalanceSheetCtx(context.Background(), symbol)
+}
+
+// GetBalanceSheetCtx is like GetBalanceSheet but aborts when ctx is done
+func (c *Client) GetBalanceSheetCtx(ctx context.Context, symbol string) (*BalanceSheetResponse, error) {
 	params := map[string]string{
 		"function": "BALANCE_SHEET",
 		"symbol":   symbol,
 	}
 
-	body, err := c.doRequest(params)
+	body, err := c.doRequest(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
 	var result BalanceSheetResponse
 	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, avDecodeError(params, err)
+	}
+
+	return &result, nil
+}
+
+// GetIncomeStatement returns income statement data for a symbol
+func (c *Client) GetIncomeStatement(symbol string) (*IncomeStatementResponse, error) {
+	return c.GetIncomeStatementCtx(context.Background(), symbol)
+}
+
+// GetIncomeStatementCtx is like GetIncomeStatement but aborts when ctx is done
+func (c *Client) GetIncomeStatementCtx(ctx context.Context, symbol string) (*IncomeStatementResponse, error) {
+	params := map[string]string{
+		"function": "INCOME_STATEMENT",
+		"symbol":   symbol,
+	}
+
+	body, err := c.doRequest(ctx, params)
+	if err != nil {
 		return nil, err
 	}
 
+	var result IncomeStatementResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, avDecodeError(params, err)
+	}
+
 	return &result, nil
 }
 
+// IncomeMargins holds the margins of one income statement as fractions, 0.25 = 25%
+type IncomeMargins struct {
+	Gross     float64
+	Operating float64
+	Net       float64
+}
+
+// Margins calculates gross, operating and net margin. All are 0 when
+// revenue is missing or zero
+func (r IncomeStatementReport) Margins() IncomeMargins {
+	revenue, _ := parseFloat(r.TotalRevenue)
+	if revenue == 0 {
+		return IncomeMargins{}
+	}
+	gross, _ := parseFloat(r.GrossProfit)
+	operating, _ := parseFloat(r.OperatingIncome)
+	net, _ := parseFloat(r.NetIncome)
+	return IncomeMargins{
+		Gross:     gross / revenue,
+		Operating: operating / revenue,
+		Net:       net / revenue,
+	}
+}
+
 // GetCashFlow returns cash flow data for a symbol
 func (c *Client) GetCashFlow(symbol string) (*CashFlowResponse, error) {
+	return c.GetCashFlowCtx(context.Background(), symbol)
+}
+
+// GetCashFlowCtx is like GetCashFlow but aborts when ctx is done
+func (c *Client) GetCashFlowCtx(ctx context.Context, symbol string) (*CashFlowResponse, error) {
 	params := map[string]string{
 		"function": "CASH_FLOW",
 		"symbol":   symbol,
 	}
 
-	body, err := c.doRequest(params)
+	body, err := c.doRequest(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
 	var result CashFlowResponse
 	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, err
+		return nil, avDecodeError(params, err)
 	}
 
 	return &result, nil
@@ -44,19 +143,24 @@ func (c *Client) GetCashFlow(symbol string) (*CashFlowResponse, error) {
 
 // GetEarnings returns earnings data for a symbol
 func (c *Client) GetEarnings(symbol string) (*EarningsResponse, error) {
+	return c.GetEarningsCtx(context.Background(), symbol)
+}
+
+// GetEarningsCtx is like GetEarnings but aborts when ctx is done
+func (c *Client) GetEarningsCtx(ctx context.Context, symbol string) (*EarningsResponse, error) {
 	params := map[string]string{
 		"function": "EARNINGS",
 		"symbol":   symbol,
 	}
 
-	body, err := c.doRequest(params)
+	body, err := c.doRequest(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
 	var result EarningsResponse
 	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, err
+		return nil, avDecodeError(params, err)
 	}
 
 	return &result, nil
@@ -0,0 +1,248 @@
+package alphavintage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// GetExchangeRate returns the realtime rate between two currencies, physical
+// (USD, EUR) or digital (BTC)
+func (c *Client) GetExchangeRate(from, to string) (*ExchangeRate, error) {
+	return c.GetExchangeRateCtx(context.Background(), from, to)
+}
+
+// GetExchangeRateCtx is like GetExchangeRate but aborts when ctx is done
+func (c *Client) GetExchangeRateCtx(ctx context.Context, from, to string) (*ExchangeRate, error) {
+	params := map[string]string{
+		"function":      "CURRENCY_EXCHANGE_RATE",
+		"from_currency": from,
+		"to_currency":   to,
+	}
+
+	body, err := c.doRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result CurrencyExchangeRateResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, avDecodeError(params, err)
+	}
+
+	if result.Rate.ExchangeRate == "" {
+		return nil, &APIError{
+			Kind:     ErrAPI,
+			Provider: ProviderAlphaVantage,
+			Function: params["function"],
+			Message:  fmt.Sprintf("no exchange rate returned for %s/%s", from, to),
+		}
+	}
+
+	return &result.Rate, nil
+}
+
+// Convert returns amount, in the from currency, in the to currency
+func (r ExchangeRate) Convert(amount float64) float64 {
+	rate, _ := parseFloat(r.ExchangeRate)
+	return amount * rate
+}
+
+// GetFXIntraday returns intraday OHLC data for a currency pair (premium)
+func (c *Client) GetFXIntraday(from, to string, interval Interval, outputSize OutputSize) (*FXSeriesResponse, error) {
+	return c.GetFXIntradayCtx(context.Background(), from, to, interval, outputSize)
+}
+
+// GetFXIntradayCtx is like GetFXIntraday but aborts when ctx is done
+func (c *Client) GetFXIntradayCtx(ctx context.Context, from, to string, interval Interval, outputSize OutputSize) (*FXSeriesResponse, error) {
+	params := map[string]string{
+		"function":    "FX_INTRADAY",
+		"from_symbol": from,
+		"to_symbol":   to,
+		"interval":    string(interval),
+	}
+	if outputSize != "" {
+		params["outputsize"] = string(outputSize)
+	}
+	return c.getFXSeries(ctx, params, fmt.Sprintf("Time Series FX (%s)", interval))
+}
+
+// GetFXDaily returns daily OHLC data for a currency pair
+func (c *Client) GetFXDaily(from, to string, outputSize OutputSize) (*FXSeriesResponse, error) {
+	return c.GetFXDailyCtx(context.Background(), from, to, outputSize)
+}
+
+// GetFXDailyCtx is like GetFXDaily but aborts when ctx is done
+func (c *Client) GetFXDailyCtx(ctx context.Context, from, to string, outputSize OutputSize) (*FXSeriesResponse, error) {
+	params := map[string]string{
+		"function":    "FX_DAILY",
+		"from_symbol": from,
+		"to_symbol":   to,
+	}
+	if outputSize != "" {
+		params["outputsize"] = string(outputSize)
+	}
+	return c.getFXSeries(ctx, params, "Time Series FX (Daily)")
+}
+
+// GetFXWeekly returns weekly OHLC data for a currency pair
+func (c *Client) GetFXWeekly(from, to string) (*FXSeriesResponse, error) {
+	return c.GetFXWeeklyCtx(context.Background(), from, to)
+}
+
+// GetFXWeeklyCtx is like GetFXWeekly but aborts when ctx is done
+func (c *Client) GetFXWeeklyCtx(ctx context.Context, from, to string) (*FXSeriesResponse, error) {
+	params := map[string]string{
+		"function":    "FX_WEEKLY",
+		"from_symbol": from,
+		"to_symbol":   to,
+	}
+	return c.getFXSeries(ctx, params, "Time Series FX (Weekly)")
+}
+
+// GetFXMonthly returns monthly OHLC data for a currency pair
+func (c *Client) GetFXMonthly(from, to string) (*FXSeriesResponse, error) {
+	return c.GetFXMonthlyCtx(context.Background(), from, to)
+}
+
+// GetFXMonthlyCtx is like GetFXMonthly but aborts when ctx is done
+func (c *Client) GetFXMonthlyCtx(ctx context.Context, from, to string) (*FXSeriesResponse, error) {
+	params := map[string]string{
+		"function":    "FX_MONTHLY",
+		"from_symbol": from,
+		"to_symbol":   to,
+	}
+	return c.getFXSeries(ctx, params, "Time Series FX (Monthly)")
+}
+
+func (c *Client) getFXSeries(ctx context.Context, params map[string]string, seriesKey string) (*FXSeriesResponse, error) {
+	body, err := c.doRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, avDecodeError(params, err)
+	}
+
+	result := FXSeriesResponse{TimeSeries: make(map[string]FXDataPoint)}
+	if meta := raw["Meta Data"]; meta != nil {
+		var fields map[string]string
+		if err := json.Unmarshal(meta, &fields); err != nil {
+			return nil, avDecodeError(params, err)
+		}
+		result.MetaData = parseFXMetaData(fields)
+	}
+	if raw[seriesKey] == nil {
+		return nil, avMissingSeries(params, seriesKey)
+	}
+	if err := json.Unmarshal(raw[seriesKey], &result.TimeSeries); err != nil {
+		return nil, avDecodeError(params, err)
+	}
+	return &result, nil
+}
+
+// parseFXMetaData reads "Meta Data" by field name, like parseTimeSeriesMetaData
+func parseFXMetaData(fields map[string]string) FXMetaData {
+	var meta FXMetaData
+	for key, value := range fields {
+		if i := strings.Index(key, ". "); i >= 0 {
+			key = key[i+2:]
+		}
+		switch key {
+		case "Information":
+			meta.Information = value
+		case "From Symbol":
+			meta.FromSymbol = value
+		case "To Symbol":
+			meta.ToSymbol = value
+		case "Last Refreshed":
+			meta.LastRefreshed = value
+		case "Interval":
+			meta.Interval = value
+		case "Output Size":
+			meta.OutputSize = value
+		case "Time Zone":
+			meta.TimeZone = value
+		}
+	}
+	return meta
+}
+
+// ToDaily converts an FX series so the daily filters, summaries and chart
+// functions accept it. The symbol becomes "FROM/TO" and volume is 0
+func (r *FXSeriesResponse) ToDaily() *TimeSeriesDailyResponse {
+	if r == nil {
+		return nil
+	}
+
+	daily := &TimeSeriesDailyResponse{
+		MetaData: TimeSeriesMetaData{
+			Information:   r.MetaData.Information,
+			Symbol:        r.MetaData.FromSymbol + "/" + r.MetaData.ToSymbol,
+			LastRefreshed: r.MetaData.LastRefreshed,
+			OutputSize:    r.MetaData.OutputSize,
+			TimeZone:      r.MetaData.TimeZone,
+		},
+		TimeSeries: make(map[string]DailyDataPoint, len(r.TimeSeries)),
+	}
+	for date, p := range r.TimeSeries {
+		daily.TimeSeries[date] = DailyDataPoint{Open: p.Open, High: p.High, Low: p.Low, Close: p.Close, Volume: "0"}
+	}
+	return daily
+}
+
+// ConvertBalanceSheetReport returns a copy of report with every amount
+// converted from its ReportedCurrency using rate. The share count is left as is
+func ConvertBalanceSheetReport(report BalanceSheetReport, rate *ExchangeRate) (BalanceSheetReport, error) {
+	err := convertReport(&report, &report.ReportedCurrency, rate, "CommonStockSharesOutstanding")
+	return report, err
+}
+
+// ConvertCashFlowReport returns a copy of report with every amount converted
+// from its ReportedCurrency using rate
+func ConvertCashFlowReport(report CashFlowReport, rate *ExchangeRate) (CashFlowReport, error) {
+	err := convertReport(&report, &report.ReportedCurrency, rate)
+	return report, err
+}
+
+// convertReport scales the numeric string fields of the struct report points
+// to, other than FiscalDateEnding, ReportedCurrency and skip. Values such as
+// "None" are left untouched
+func convertReport(report interface{}, currency *string, rate *ExchangeRate, skip ...string) error {
+	if rate == nil {
+		return fmt.Errorf("no exchange rate")
+	}
+	if !strings.EqualFold(*currency, rate.FromCurrencyCode) {
+		return fmt.Errorf("report is in %s, exchange rate is from %s", *currency, rate.FromCurrencyCode)
+	}
+	factor, err := parseFloat(rate.ExchangeRate)
+	if err != nil {
+		return fmt.Errorf("invalid exchange rate %q", rate.ExchangeRate)
+	}
+
+	skipped := map[string]bool{"FiscalDateEnding": true, "ReportedCurrency": true}
+	for _, name := range skip {
+		skipped[name] = true
+	}
+
+	v := reflect.ValueOf(report).Elem()
+	t := v.Type()
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if f.Kind() != reflect.String || skipped[t.Field(i).Name] {
+			continue
+		}
+		amount, err := strconv.ParseFloat(f.String(), 64)
+		if err != nil {
+			continue
+		}
+		f.SetString(strconv.FormatFloat(amount*factor, 'f', 0, 64))
+	}
+	*currency = strings.ToUpper(rate.ToCurrencyCode)
+	return nil
+}
@@ -0,0 +1,49 @@
+package alphavintage
+
+import "testing"
+
+func TestConvertReports(t *testing.T) {
+	rate := &ExchangeRate{FromCurrencyCode: "EUR", ToCurrencyCode: "usd", ExchangeRate: "1.5"}
+
+	balance, err := ConvertBalanceSheetReport(BalanceSheetReport{
+		FiscalDateEnding:             "2023-12-31",
+		ReportedCurrency:             "EUR",
+		TotalAssets:                  "1000",
+		Inventory:                    "None",
+		CommonStockSharesOutstanding: "200",
+	}, rate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := BalanceSheetReport{
+		FiscalDateEnding:             "2023-12-31",
+		ReportedCurrency:             "USD",
+		TotalAssets:                  "1500",
+		Inventory:                    "None",
+		CommonStockSharesOutstanding: "200", // A share count, not an amount
+	}
+	if balance != want {
+		t.Fatalf("balance sheet = %+v; want %+v", balance, want)
+	}
+
+	cashFlow, err := ConvertCashFlowReport(CashFlowReport{
+		FiscalDateEnding:  "2023-12-31",
+		ReportedCurrency:  "eur",
+		OperatingCashflow: "-400",
+		NetIncome:         "None",
+	}, rate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cashFlow.OperatingCashflow != "-600" || cashFlow.NetIncome != "None" || cashFlow.ReportedCurrency != "USD" {
+		t.Fatalf("cash flow = %+v; want -600, None and USD", cashFlow)
+	}
+
+	// A report in another currency than the rate converts from is rejected
+	if _, err := ConvertCashFlowReport(CashFlowReport{ReportedCurrency: "JPY"}, rate); err == nil {
+		t.Fatal("cash flow in JPY converted with a EUR rate")
+	}
+	if _, err := ConvertBalanceSheetReport(BalanceSheetReport{ReportedCurrency: "JPY"}, rate); err == nil {
+		t.Fatal("balance sheet in JPY converted with a EUR rate")
+	}
+}
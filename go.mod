@@ -3,11 +3,14 @@ module github.com/SwanHtetAungPhyo/alphavintage
 go 1.25.4
 
 require (
-	github.com/go-resty/resty/v2 v2.17.1 // indirect
+	github.com/go-resty/resty/v2 v2.17.1
+	github.com/jung-kurt/gofpdf v1.16.2
+	github.com/wcharczuk/go-chart/v2 v2.1.2
+)
+
+require (
 	github.com/golang/freetype v0.0.0-20170609003504-e2365dfdc4a0 // indirect
-	github.com/jung-kurt/gofpdf v1.16.2 // indirect
 	github.com/sashabaranov/go-openai v1.41.2 // indirect
-	github.com/wcharczuk/go-chart/v2 v2.1.2 // indirect
 	golang.org/x/image v0.18.0 // indirect
 	golang.org/x/net v0.43.0 // indirect
 )
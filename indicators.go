@@ -0,0 +1,225 @@
+package alphavintage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// TechnicalIndicator is an Alpha Vantage technical indicator function. Any
+// indicator function name works, these are the common ones
+type TechnicalIndicator string
+
+const (
+	TechnicalSMA      TechnicalIndicator = "SMA"
+	TechnicalEMA      TechnicalIndicator = "EMA"
+	TechnicalWMA      TechnicalIndicator = "WMA"
+	TechnicalDEMA     TechnicalIndicator = "DEMA"
+	TechnicalTEMA     TechnicalIndicator = "TEMA"
+	TechnicalVWAP     TechnicalIndicator = "VWAP" // Intraday intervals only
+	TechnicalMACD     TechnicalIndicator = "MACD"
+	TechnicalSTOCH    TechnicalIndicator = "STOCH"
+	TechnicalRSI      TechnicalIndicator = "RSI"
+	TechnicalSTOCHRSI TechnicalIndicator = "STOCHRSI"
+	TechnicalWILLR    TechnicalIndicator = "WILLR"
+	TechnicalADX      TechnicalIndicator = "ADX"
+	TechnicalCCI      TechnicalIndicator = "CCI"
+	TechnicalAROON    TechnicalIndicator = "AROON"
+	TechnicalMFI      TechnicalIndicator = "MFI"
+	TechnicalBBANDS   TechnicalIndicator = "BBANDS"
+	TechnicalAD       TechnicalIndicator = "AD"
+	TechnicalOBV      TechnicalIndicator = "OBV"
+	TechnicalATR      TechnicalIndicator = "ATR"
+	TechnicalSAR      TechnicalIndicator = "SAR"
+)
+
+// SeriesType selects the price an indicator is computed from
+type SeriesType string
+
+const (
+	SeriesClose SeriesType = "close"
+	SeriesOpen  SeriesType = "open"
+	SeriesHigh  SeriesType = "high"
+	SeriesLow   SeriesType = "low"
+)
+
+// IndicatorOptions contains options for technical indicator APIs. Which
+// ones an indicator needs is listed in the Alpha Vantage documentation
+type IndicatorOptions struct {
+	Interval   Interval          // Intraday interval, IntervalDaily, IntervalWeekly or IntervalMonthly. Empty = daily
+	TimePeriod int               // Data points per value, e.g. 20 for a 20-day SMA
+	SeriesType SeriesType        // Price the indicator is computed from
+	Month      string            // YYYY-MM, intraday intervals only
+	Params     map[string]string // Indicator specific, e.g. "fastperiod" for MACD or "nbdevup" for BBANDS
+}
+
+// GetTechnicalIndicator returns a technical indicator series for a symbol
+func (c *Client) GetTechnicalIndicator(indicator TechnicalIndicator, symbol string, opts *IndicatorOptions) (*IndicatorSeries, error) {
+	return c.GetTechnicalIndicatorCtx(context.Background(), indicator, symbol, opts)
+}
+
+// GetTechnicalIndicatorCtx is like GetTechnicalIndicator but aborts when ctx is done
+func (c *Client) GetTechnicalIndicatorCtx(ctx context.Context, indicator TechnicalIndicator, symbol string, opts *IndicatorOptions) (*IndicatorSeries, error) {
+	params := map[string]string{
+		"function": string(indicator),
+		"symbol":   symbol,
+		"interval": string(IntervalDaily),
+	}
+
+	if opts != nil {
+		for k, v := range opts.Params {
+			params[k] = v
+		}
+		if opts.Interval != "" {
+			params["interval"] = string(opts.Interval)
+		}
+		if opts.TimePeriod > 0 {
+			params["time_period"] = strconv.Itoa(opts.TimePeriod)
+		}
+		if opts.SeriesType != "" {
+			params["series_type"] = string(opts.SeriesType)
+		}
+		if opts.Month != "" {
+			params["month"] = opts.Month
+		}
+	}
+
+	body, err := c.doRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, avDecodeError(params, err)
+	}
+
+	result := &IndicatorSeries{}
+	if raw["Meta Data"] != nil {
+		var fields map[string]interface{}
+		if err := json.Unmarshal(raw["Meta Data"], &fields); err != nil {
+			return nil, avDecodeError(params, err)
+		}
+		result.MetaData = parseIndicatorMetaData(fields)
+	}
+
+	// The key names the indicator, which doesn't always match the
+	// function, e.g. "Technical Analysis: Chaikin A/D" for AD
+	var series json.RawMessage
+	for key, value := range raw {
+		if strings.HasPrefix(key, "Technical Analysis") {
+			series = value
+			break
+		}
+	}
+	if series == nil {
+		return nil, &APIError{
+			Kind:     ErrAPI,
+			Provider: ProviderAlphaVantage,
+			Function: params["function"],
+			Symbol:   symbol,
+			Message:  "no indicator values returned",
+		}
+	}
+
+	var points map[string]map[string]string
+	if err := json.Unmarshal(series, &points); err != nil {
+		return nil, avDecodeError(params, err)
+	}
+
+	columns := make(map[string]bool)
+	for t, values := range points {
+		point := IndicatorPoint{Time: t, Values: make(map[string]float64, len(values))}
+		for column, value := range values {
+			v, err := parseFloat(value)
+			if err != nil {
+				continue
+			}
+			point.Values[column] = v
+			columns[column] = true
+		}
+		result.Points = append(result.Points, point)
+	}
+	sort.Slice(result.Points, func(i, j int) bool {
+		return result.Points[i].Time < result.Points[j].Time
+	})
+	for column := range columns {
+		result.Columns = append(result.Columns, column)
+	}
+	sort.Strings(result.Columns)
+
+	return result, nil
+}
+
+// parseIndicatorMetaData reads "Meta Data" by field name. Indicators number
+// their fields differently and use "1: Symbol" rather than "1. Symbol"
+func parseIndicatorMetaData(fields map[string]interface{}) IndicatorMetaData {
+	var meta IndicatorMetaData
+	for key, value := range fields {
+		if i := strings.IndexAny(key, ":."); i >= 0 {
+			key = strings.TrimSpace(key[i+1:])
+		}
+		s := fmt.Sprint(value)
+		switch key {
+		case "Symbol":
+			meta.Symbol = s
+		case "Indicator":
+			meta.Indicator = s
+		case "Last Refreshed":
+			meta.LastRefreshed = s
+		case "Interval":
+			meta.Interval = s
+		case "Time Period":
+			meta.TimePeriod = s
+		case "Series Type":
+			meta.SeriesType = s
+		case "Time Zone":
+			meta.TimeZone = s
+		}
+	}
+	return meta
+}
+
+// Column returns the times and values of one column, oldest first
+func (s *IndicatorSeries) Column(name string) ([]time.Time, []float64) {
+	if s == nil {
+		return nil, nil
+	}
+	var times []time.Time
+	var values []float64
+	for _, p := range s.Points {
+		v, ok := p.Values[name]
+		if !ok {
+			continue
+		}
+		t, err := parseIndicatorTime(p.Time)
+		if err != nil {
+			continue
+		}
+		times = append(times, t)
+		values = append(values, v)
+	}
+	return times, values
+}
+
+// Latest returns the newest point, or false if the series is empty
+func (s *IndicatorSeries) Latest() (IndicatorPoint, bool) {
+	if s == nil || len(s.Points) == 0 {
+		return IndicatorPoint{}, false
+	}
+	return s.Points[len(s.Points)-1], true
+}
+
+func parseIndicatorTime(t string) (time.Time, error) {
+	switch len(t) {
+	case len("2006-01-02"):
+		return time.Parse("2006-01-02", t)
+	case len("2006-01-02 15:04"):
+		return time.Parse("2006-01-02 15:04", t)
+	}
+	return time.Parse("2006-01-02 15:04:05", t)
+}
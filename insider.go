@@ -0,0 +1,157 @@
+package alphavintage
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// GetInsiderTransactions returns the insider transactions of a symbol,
+// newest first. Alpha Vantage has no institutional ownership endpoint, use
+// FinancialDatasetsClient.GetInstitutionalOwnership for holdings
+func (c *Client) GetInsiderTransactions(symbol string) (*InsiderTransactionsResponse, error) {
+	return c.GetInsiderTransactionsCtx(context.Background(), symbol)
+}
+
+// GetInsiderTransactionsCtx is like GetInsiderTransactions but aborts when ctx is done
+func (c *Client) GetInsiderTransactionsCtx(ctx context.Context, symbol string) (*InsiderTransactionsResponse, error) {
+	params := map[string]string{
+		"function": "INSIDER_TRANSACTIONS",
+		"symbol":   symbol,
+	}
+
+	body, err := c.doRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result InsiderTransactionsResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, avDecodeError(params, err)
+	}
+
+	return &result, nil
+}
+
+// IsDerivative reports whether the transaction is in options, warrants or
+// units rather than the shares themselves
+func (t InsiderTransaction) IsDerivative() bool {
+	security := strings.ToLower(t.SecurityType)
+	for _, kind := range []string{"option", "warrant", "right", "unit"} {
+		if strings.Contains(security, kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// Trade converts the transaction to the Financial Datasets shape. Disposals
+// get negative shares and value. Alpha Vantage doesn't report holdings or
+// filing dates, so those fields are left zero
+func (t InsiderTransaction) Trade() FDInsiderTrade {
+	shares, _ := parseFloat(t.Shares)
+	if t.AcquisitionOrDisposal == "D" {
+		shares = -shares
+	}
+	price, _ := parseFloat(t.SharePrice)
+	return FDInsiderTrade{
+		Ticker:                   t.Ticker,
+		Name:                     t.Executive,
+		Title:                    t.ExecutiveTitle,
+		IsBoardDirector:          strings.Contains(strings.ToLower(t.ExecutiveTitle), "director"),
+		TransactionDate:          t.TransactionDate,
+		TransactionShares:        shares,
+		TransactionPricePerShare: price,
+		TransactionValue:         shares * price,
+	}
+}
+
+// Trades converts the share transactions to the Financial Datasets shape,
+// so they work with ReportBuilder.AddFDInsiderTrades and
+// SummarizeInsiderTrades. Derivative transactions are skipped
+func (r *InsiderTransactionsResponse) Trades() []FDInsiderTrade {
+	var trades []FDInsiderTrade
+	for _, t := range r.Data {
+		if t.IsDerivative() {
+			continue
+		}
+		trades = append(trades, t.Trade())
+	}
+	return trades
+}
+
+// InsiderActivity aggregates insider trades over a period. Acquisitions at
+// no price are stock awards and are counted as grants, not buys
+type InsiderActivity struct {
+	From, To     string
+	Insiders     int
+	Buys         int
+	Sells        int
+	Grants       int
+	SharesBought float64
+	SharesSold   float64
+	SharesGrants float64
+	ValueBought  float64
+	ValueSold    float64
+}
+
+// NetShares returns shares bought minus shares sold
+func (a InsiderActivity) NetShares() float64 {
+	return a.SharesBought - a.SharesSold
+}
+
+// NetValue returns value bought minus value sold
+func (a InsiderActivity) NetValue() float64 {
+	return a.ValueBought - a.ValueSold
+}
+
+// Direction returns "net buying", "net selling" or "neutral" by value
+func (a InsiderActivity) Direction() string {
+	switch {
+	case a.NetValue() > 0:
+		return "net buying"
+	case a.NetValue() < 0:
+		return "net selling"
+	}
+	return "neutral"
+}
+
+// SummarizeInsiderTrades aggregates trades on or after since (YYYY-MM-DD,
+// empty for all). It works with trades from either provider
+func SummarizeInsiderTrades(trades []FDInsiderTrade, since string) InsiderActivity {
+	var activity InsiderActivity
+	insiders := make(map[string]bool)
+	for _, t := range trades {
+		if since != "" && t.TransactionDate < since {
+			continue
+		}
+		if activity.From == "" || t.TransactionDate < activity.From {
+			activity.From = t.TransactionDate
+		}
+		if t.TransactionDate > activity.To {
+			activity.To = t.TransactionDate
+		}
+		insiders[t.Name] = true
+
+		shares := abs(t.TransactionShares)
+		value := abs(t.TransactionValue)
+		if value == 0 {
+			value = shares * t.TransactionPricePerShare
+		}
+		switch {
+		case t.TransactionShares < 0:
+			activity.Sells++
+			activity.SharesSold += shares
+			activity.ValueSold += value
+		case value == 0:
+			activity.Grants++
+			activity.SharesGrants += shares
+		default:
+			activity.Buys++
+			activity.SharesBought += shares
+			activity.ValueBought += value
+		}
+	}
+	activity.Insiders = len(insiders)
+	return activity
+}
@@ -0,0 +1,79 @@
+package alphavintage
+
+import "testing"
+
+func TestInsiderTransactionTrade(t *testing.T) {
+	tests := []struct {
+		name string
+		tx   InsiderTransaction
+		want FDInsiderTrade
+	}{
+		{
+			name: "sale",
+			tx: InsiderTransaction{TransactionDate: "2024-11-04", Ticker: "IBM", Executive: "Doe, Jane", ExecutiveTitle: "Chief Financial Officer",
+				SecurityType: "Common Stock", AcquisitionOrDisposal: "D", Shares: "1000.0", SharePrice: "210.5"},
+			want: FDInsiderTrade{Ticker: "IBM", Name: "Doe, Jane", Title: "Chief Financial Officer", TransactionDate: "2024-11-04",
+				TransactionShares: -1000, TransactionPricePerShare: 210.5, TransactionValue: -210500},
+		},
+		{
+			name: "priced buy by a director",
+			tx: InsiderTransaction{TransactionDate: "2024-11-05", Ticker: "IBM", Executive: "Roe, Sam", ExecutiveTitle: "Director",
+				SecurityType: "Common Stock", AcquisitionOrDisposal: "A", Shares: "500.0", SharePrice: "200.0"},
+			want: FDInsiderTrade{Ticker: "IBM", Name: "Roe, Sam", Title: "Director", IsBoardDirector: true, TransactionDate: "2024-11-05",
+				TransactionShares: 500, TransactionPricePerShare: 200, TransactionValue: 100000},
+		},
+		{
+			name: "zero-price award",
+			tx: InsiderTransaction{TransactionDate: "2024-11-06", Ticker: "IBM", Executive: "Poe, Max", ExecutiveTitle: "SVP, Former Director of Sales",
+				SecurityType: "Common Stock", AcquisitionOrDisposal: "A", Shares: "300.0", SharePrice: "0.0"},
+			// The title check is a plain substring match
+			want: FDInsiderTrade{Ticker: "IBM", Name: "Poe, Max", Title: "SVP, Former Director of Sales", IsBoardDirector: true,
+				TransactionDate: "2024-11-06", TransactionShares: 300},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.tx.Trade(); got != tt.want {
+				t.Fatalf("Trade = %+v; want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSummarizeInsiderTrades(t *testing.T) {
+	av := &InsiderTransactionsResponse{Data: []InsiderTransaction{
+		{TransactionDate: "2024-11-04", Executive: "Doe, Jane", SecurityType: "Common Stock", AcquisitionOrDisposal: "D", Shares: "1000", SharePrice: "210"},
+		{TransactionDate: "2024-11-06", Executive: "Poe, Max", SecurityType: "Common Stock", AcquisitionOrDisposal: "A", Shares: "300", SharePrice: "0"},
+		// Skipped by Trades
+		{TransactionDate: "2024-11-07", Executive: "Doe, Jane", SecurityType: "Employee Stock Option (Right to Buy)", AcquisitionOrDisposal: "A", Shares: "5000", SharePrice: "150"},
+		// Before since
+		{TransactionDate: "2024-01-02", Executive: "Old, Tim", SecurityType: "Common Stock", AcquisitionOrDisposal: "D", Shares: "10", SharePrice: "150"},
+	}}
+	trades := av.Trades()
+	if len(trades) != 3 {
+		t.Fatalf("Trades returned %d trades; want the derivative skipped", len(trades))
+	}
+	trades = append(trades,
+		// Financial Datasets only sets the price, the value is derived
+		FDInsiderTrade{Name: "ROE SAM", TransactionDate: "2024-11-05", TransactionShares: 500, TransactionPricePerShare: 200},
+		FDInsiderTrade{Name: "DOE JANE", TransactionDate: "2024-12-01", TransactionShares: -100, TransactionValue: -21000},
+	)
+
+	got := SummarizeInsiderTrades(trades, "2024-06-01")
+	want := InsiderActivity{
+		From:         "2024-11-04",
+		To:           "2024-12-01",
+		Insiders:     4,
+		Buys:         1,
+		Sells:        2,
+		Grants:       1,
+		SharesBought: 500,
+		SharesSold:   1100,
+		SharesGrants: 300,
+		ValueBought:  100000,
+		ValueSold:    231000,
+	}
+	if got != want {
+		t.Fatalf("SummarizeInsiderTrades = %+v; want %+v", got, want)
+	}
+}
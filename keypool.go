@@ -0,0 +1,256 @@
+package alphavintage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrKeysExhausted is returned when every key in a KeyPool is invalid or has
+// used up its daily quota. It matches ErrRateLimited with errors.Is
+var ErrKeysExhausted = fmt.Errorf("%w: every API key is exhausted", ErrRateLimited)
+
+// KeyStrategy decides which key of a KeyPool serves the next request
+type KeyStrategy int
+
+const (
+	// KeyRoundRobin spreads requests evenly over all keys
+	KeyRoundRobin KeyStrategy = iota
+	// KeyFailover uses one key until it is rate-limited or rejected, then the next
+	KeyFailover
+)
+
+// KeyPool shares requests between several Alpha Vantage API keys. A key that
+// gets a rate-limit Note rests for a minute, one that hits its daily quota
+// rests until 00:00 UTC and one rejected as invalid is dropped. It is safe
+// for concurrent use
+type KeyPool struct {
+	strategy KeyStrategy
+
+	mu      sync.Mutex
+	keys    []*pooledKey
+	current int
+}
+
+type pooledKey struct {
+	key     string
+	limiter *RateLimiter
+
+	day         time.Time // UTC day the counts belong to
+	requests    int
+	rateLimited int
+	restUntil   time.Time
+	invalid     bool
+}
+
+// KeyUsage reports how one key of a KeyPool has been used today (UTC)
+type KeyUsage struct {
+	Key         string // Masked, only the last 4 characters are shown
+	Requests    int    // Requests sent with the key
+	RateLimited int    // Rate-limit answers received
+	Remaining   int    // Left in the per-key daily budget, -1 = no budget
+	Resting     bool   // Skipped until its rate limit or daily quota clears
+	Invalid     bool   // Rejected by the API and no longer used
+}
+
+// NewKeyPool creates a pool over keys
+func NewKeyPool(keys []string, strategy KeyStrategy) *KeyPool {
+	p := &KeyPool{strategy: strategy}
+	for _, key := range keys {
+		p.keys = append(p.keys, &pooledKey{key: key})
+	}
+	return p
+}
+
+// WithRateLimit gives every key its own rate limiter, e.g. FreeTierRateLimit()
+// for a pool of free keys. A key whose daily budget is used up is skipped
+func (p *KeyPool) WithRateLimit(policy RateLimitPolicy) *KeyPool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, k := range p.keys {
+		k.limiter = NewRateLimiter(policy)
+	}
+	return p
+}
+
+// Stats returns today's usage of every key, in pool order
+func (p *KeyPool) Stats() []KeyUsage {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	stats := make([]KeyUsage, len(p.keys))
+	for i, k := range p.keys {
+		k.rollDay(now)
+		remaining := -1
+		if k.limiter != nil {
+			remaining = k.limiter.RemainingToday()
+		}
+		stats[i] = KeyUsage{
+			Key:         maskKey(k.key),
+			Requests:    k.requests,
+			RateLimited: k.rateLimited,
+			Remaining:   remaining,
+			Resting:     now.Before(k.restUntil),
+			Invalid:     k.invalid,
+		}
+	}
+	return stats
+}
+
+// RemainingToday returns the sum of the per-key daily budgets, or -1 when
+// the pool has no per-key budget
+func (p *KeyPool) RemainingToday() int {
+	total := 0
+	for _, s := range p.Stats() {
+		if s.Remaining < 0 {
+			return -1
+		}
+		if !s.Invalid {
+			total += s.Remaining
+		}
+	}
+	return total
+}
+
+// do calls send with a key from the pool, moving on to the next key while
+// the answer is a rate limit or an invalid key
+func (p *KeyPool) do(ctx context.Context, send func(apiKey string) ([]byte, error)) ([]byte, error) {
+	tried := make(map[*pooledKey]bool)
+	var lastErr error
+	for {
+		k, err := p.acquire(ctx, tried)
+		if err != nil {
+			if errors.Is(err, ErrKeysExhausted) && lastErr != nil {
+				// Every key failed during this call, report why
+				return nil, lastErr
+			}
+			return nil, err
+		}
+
+		body, err := send(k.key)
+		if err == nil || !p.release(k, err) {
+			return body, err
+		}
+		tried[k] = true
+		lastErr = err
+	}
+}
+
+// acquire picks the next usable key that hasn't been tried yet. When every
+// such key is only waiting on its per-minute limit, it waits for the first
+// one to free up
+func (p *KeyPool) acquire(ctx context.Context, tried map[*pooledKey]bool) (*pooledKey, error) {
+	for {
+		k, delay := p.pick(time.Now(), tried)
+		if k != nil {
+			return k, nil
+		}
+		if delay == 0 {
+			return nil, ErrKeysExhausted
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// pick takes a slot on the next usable key, otherwise reports the shortest
+// wait until one frees up, or 0 if none will today
+func (p *KeyPool) pick(now time.Time, tried map[*pooledKey]bool) (*pooledKey, time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var wait time.Duration
+	for i := range p.keys {
+		idx := (p.current + i) % len(p.keys)
+		k := p.keys[idx]
+		k.rollDay(now)
+		if k.invalid || tried[k] {
+			continue
+		}
+		if now.Before(k.restUntil) {
+			if d := k.restUntil.Sub(now); d <= time.Minute && (wait == 0 || d < wait) {
+				wait = d
+			}
+			continue
+		}
+		if k.limiter != nil {
+			d, err := k.limiter.reserve(now)
+			if errors.Is(err, ErrDailyBudgetExhausted) {
+				k.restUntil = nextUTCDay(now)
+				continue
+			}
+			if err != nil {
+				// A FailFast per-minute limit, rest until its window frees up
+				k.restUntil = now.Add(d)
+			}
+			if d > 0 {
+				if wait == 0 || d < wait {
+					wait = d
+				}
+				continue
+			}
+		}
+
+		if p.strategy == KeyRoundRobin {
+			p.current = idx + 1
+		} else {
+			p.current = idx
+		}
+		k.requests++
+		return k, 0
+	}
+	return nil, wait
+}
+
+// release records the outcome of a request and reports whether another
+// key could succeed where this one failed
+func (p *KeyPool) release(k *pooledKey, err error) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := time.Now()
+	switch {
+	case errors.Is(err, ErrInvalidAPIKey):
+		k.invalid = true
+		return true
+	case errors.Is(err, ErrRateLimited):
+		k.rateLimited++
+		var apiErr *APIError
+		if errors.As(err, &apiErr) && isDailyQuotaMessage(apiErr.Message) {
+			k.restUntil = nextUTCDay(now)
+		} else {
+			k.restUntil = now.Add(time.Minute)
+		}
+		return true
+	}
+	return false
+}
+
+func (k *pooledKey) rollDay(now time.Time) {
+	day := now.UTC().Truncate(24 * time.Hour)
+	if !day.Equal(k.day) {
+		k.day = day
+		k.requests = 0
+		k.rateLimited = 0
+	}
+}
+
+func nextUTCDay(now time.Time) time.Time {
+	return now.UTC().Truncate(24 * time.Hour).Add(24 * time.Hour)
+}
+
+func maskKey(key string) string {
+	if len(key) <= 4 {
+		return "****"
+	}
+	return "****" + key[len(key)-4:]
+}
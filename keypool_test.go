@@ -0,0 +1,200 @@
+package alphavintage
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func rateLimitErr(message string) error {
+	return &APIError{Kind: ErrRateLimited, Provider: ProviderAlphaVantage, Message: message}
+}
+
+func TestKeyPoolPickStrategies(t *testing.T) {
+	tests := []struct {
+		strategy KeyStrategy
+		want     []string
+	}{
+		{KeyRoundRobin, []string{"key-a", "key-b", "key-c", "key-a"}},
+		{KeyFailover, []string{"key-a", "key-a", "key-a", "key-a"}},
+	}
+	for _, tt := range tests {
+		p := NewKeyPool([]string{"key-a", "key-b", "key-c"}, tt.strategy)
+		for i, want := range tt.want {
+			k, _ := p.pick(day0, nil)
+			if k == nil || k.key != want {
+				t.Fatalf("strategy %d pick %d = %v; want %s", tt.strategy, i, k, want)
+			}
+		}
+	}
+}
+
+func TestKeyPoolRest(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		retry   bool
+		rest    time.Duration // Expected rest, 0 = none
+		nextDay bool          // Rests until 00:00 UTC instead
+	}{
+		{"per-minute note", rateLimitErr("Our standard API call frequency is 5 calls per minute and 500 calls per day."), true, time.Minute, false},
+		{"daily quota", rateLimitErr("Our standard API rate limit is 25 requests per day."), true, 0, true},
+		{"invalid symbol", &APIError{Kind: ErrInvalidSymbol, Message: "Invalid API call."}, false, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := NewKeyPool([]string{"key-a", "key-b"}, KeyFailover)
+			k := p.keys[0]
+
+			before := time.Now()
+			if retry := p.release(k, tt.err); retry != tt.retry {
+				t.Fatalf("release = %v; want %v", retry, tt.retry)
+			}
+
+			switch {
+			case tt.nextDay:
+				if want := nextUTCDay(before); !k.restUntil.Equal(want) {
+					t.Fatalf("restUntil = %v; want the next UTC day %v", k.restUntil, want)
+				}
+			case tt.rest > 0:
+				if rest := k.restUntil.Sub(before); rest < tt.rest || rest > tt.rest+time.Second {
+					t.Fatalf("rests for %v; want %v", rest, tt.rest)
+				}
+			default:
+				if !k.restUntil.IsZero() {
+					t.Fatalf("restUntil = %v; want no rest", k.restUntil)
+				}
+			}
+
+			// A resting key is skipped in favour of the next one
+			if got, _ := p.pick(time.Now(), nil); tt.retry && got != p.keys[1] {
+				t.Fatalf("pick = %v; want the second key while the first rests", got)
+			}
+		})
+	}
+}
+
+func TestKeyPoolPickWaits(t *testing.T) {
+	p := NewKeyPool([]string{"key-a"}, KeyRoundRobin)
+	p.keys[0].restUntil = day0.Add(30 * time.Second)
+	if k, wait := p.pick(day0, nil); k != nil || wait != 30*time.Second {
+		t.Fatalf("pick = %v, %v; want a 30s wait for the resting key", k, wait)
+	}
+
+	// Resting until tomorrow is not worth waiting for
+	p.keys[0].restUntil = nextUTCDay(day0)
+	if k, wait := p.pick(day0, nil); k != nil || wait != 0 {
+		t.Fatalf("pick = %v, %v; want no usable key today", k, wait)
+	}
+	if k, _ := p.pick(nextUTCDay(day0), nil); k == nil {
+		t.Fatal("key still resting on the next UTC day")
+	}
+
+	// A key whose own daily budget is used up rests until tomorrow
+	p = NewKeyPool([]string{"key-a"}, KeyRoundRobin).WithRateLimit(RateLimitPolicy{RequestsPerDay: 1})
+	if k, _ := p.pick(day0, nil); k == nil {
+		t.Fatal("first pick found no key")
+	}
+	if k, wait := p.pick(day0, nil); k != nil || wait != 0 {
+		t.Fatalf("pick = %v, %v; want no usable key once the budget is spent", k, wait)
+	}
+	if !p.keys[0].restUntil.Equal(nextUTCDay(day0)) {
+		t.Fatalf("restUntil = %v; want the next UTC day", p.keys[0].restUntil)
+	}
+
+	// A FailFast per-minute limit only rests the key until its window frees up
+	p = NewKeyPool([]string{"key-a"}, KeyRoundRobin).WithRateLimit(RateLimitPolicy{RequestsPerMinute: 1, FailFast: true})
+	if k, _ := p.pick(day0, nil); k == nil {
+		t.Fatal("first pick found no key")
+	}
+	if k, wait := p.pick(day0.Add(time.Second), nil); k != nil || wait != 59*time.Second {
+		t.Fatalf("pick = %v, %v; want a 59s wait for the per-minute limit", k, wait)
+	}
+	if want := day0.Add(time.Minute); !p.keys[0].restUntil.Equal(want) {
+		t.Fatalf("restUntil = %v; want %v", p.keys[0].restUntil, want)
+	}
+	if k, _ := p.pick(day0.Add(time.Minute), nil); k == nil {
+		t.Fatal("key still resting once the minute is over")
+	}
+}
+
+func TestKeyPoolDo(t *testing.T) {
+	p := NewKeyPool([]string{"key-a", "key-b", "key-c"}, KeyFailover)
+	invalidKey := &APIError{Kind: ErrInvalidAPIKey, Message: "the parameter apikey is invalid or missing."}
+
+	var sent []string
+	body, err := p.do(context.Background(), func(apiKey string) ([]byte, error) {
+		sent = append(sent, apiKey)
+		switch apiKey {
+		case "key-a":
+			return nil, invalidKey
+		case "key-b":
+			return nil, rateLimitErr("Our standard API rate limit is 25 requests per day.")
+		}
+		return []byte("{}"), nil
+	})
+	if err != nil || string(body) != "{}" {
+		t.Fatalf("do = %q, %v; want the body from key-c", body, err)
+	}
+	if len(sent) != 3 || sent[2] != "key-c" {
+		t.Fatalf("sent with %v; want failover to key-c", sent)
+	}
+
+	// A permanent failure is returned without trying other keys
+	sent = nil
+	permanent := &APIError{Kind: ErrInvalidSymbol, Message: "Invalid API call."}
+	if _, err := p.do(context.Background(), func(apiKey string) ([]byte, error) {
+		sent = append(sent, apiKey)
+		return nil, permanent
+	}); err != permanent || len(sent) != 1 {
+		t.Fatalf("do = %v after %d sends; want the invalid symbol error after 1", err, len(sent))
+	}
+}
+
+func TestKeyPoolDoExhausted(t *testing.T) {
+	p := NewKeyPool([]string{"key-a", "key-b"}, KeyRoundRobin)
+	daily := rateLimitErr("Our standard API rate limit is 25 requests per day.")
+
+	_, err := p.do(context.Background(), func(apiKey string) ([]byte, error) {
+		return nil, daily
+	})
+	if err != daily {
+		t.Fatalf("do = %v; want the last real error", err)
+	}
+
+	// Once every key rests until tomorrow nothing is sent at all
+	sent := 0
+	_, err = p.do(context.Background(), func(apiKey string) ([]byte, error) {
+		sent++
+		return []byte("{}"), nil
+	})
+	if !errors.Is(err, ErrKeysExhausted) || sent != 0 {
+		t.Fatalf("do = %v after %d sends; want ErrKeysExhausted without sending", err, sent)
+	}
+}
+
+func TestKeyPoolStats(t *testing.T) {
+	p := NewKeyPool([]string{"demo-key-1234", "abc"}, KeyRoundRobin).WithRateLimit(RateLimitPolicy{RequestsPerDay: 5})
+
+	p.do(context.Background(), func(apiKey string) ([]byte, error) {
+		return nil, rateLimitErr("Our standard API call frequency is 5 calls per minute.")
+	})
+
+	stats := p.Stats()
+	want := []KeyUsage{
+		{Key: "****1234", Requests: 1, RateLimited: 1, Remaining: 4, Resting: true},
+		{Key: "****", Requests: 1, RateLimited: 1, Remaining: 4, Resting: true},
+	}
+	if len(stats) != len(want) {
+		t.Fatalf("Stats returned %d keys; want %d", len(stats), len(want))
+	}
+	for i := range want {
+		if stats[i] != want[i] {
+			t.Fatalf("Stats[%d] = %+v; want %+v", i, stats[i], want[i])
+		}
+	}
+	if got := p.RemainingToday(); got != 8 {
+		t.Fatalf("RemainingToday = %d; want 8", got)
+	}
+}
@@ -1,22 +1,103 @@
 package alphavintage
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
 
 // GetMarketStatus returns the current market status for major trading venues
 func (c *Client) GetMarketStatus() (*MarketStatusResponse, error) {
+	return c.GetMarketStatusCtx(context.Background())
+}
+
+// GetMarketStatusCtx is like GetMarketStatus but aborts when ctx is done
+func (c *Client) GetMarketStatusCtx(ctx context.Context) (*MarketStatusResponse, error) {
 	params := map[string]string{
 		"function": "MARKET_STATUS",
 	}
 
-	body, err := c.doRequest(params)
+	body, err := c.doRequest(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
 	var result MarketStatusResponse
 	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, err
+		return nil, avDecodeError(params, err)
 	}
 
 	return &result, nil
 }
+
+// OpenMarkets returns the venues whose current status is open
+func (r *MarketStatusResponse) OpenMarkets() []Market {
+	var open []Market
+	for _, m := range r.Markets {
+		if strings.EqualFold(m.CurrentStatus, "open") {
+			open = append(open, m)
+		}
+	}
+	return open
+}
+
+// GetTopMovers returns the day's top gainers, losers and most actively
+// traded US tickers. Free keys get the lists as of the last close
+func (c *Client) GetTopMovers() (*TopMovers, error) {
+	return c.GetTopMoversCtx(context.Background())
+}
+
+// GetTopMoversCtx is like GetTopMovers but aborts when ctx is done
+func (c *Client) GetTopMoversCtx(ctx context.Context) (*TopMovers, error) {
+	params := map[string]string{
+		"function": "TOP_GAINERS_LOSERS",
+	}
+
+	body, err := c.doRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result TopMoversResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, avDecodeError(params, err)
+	}
+
+	return result.Movers(), nil
+}
+
+// Movers converts the raw TOP_GAINERS_LOSERS lists
+func (r *TopMoversResponse) Movers() *TopMovers {
+	day := r.LastUpdated
+	if len(day) >= 10 {
+		day = day[:10]
+	}
+	quotes := func(movers []Mover) []Quote {
+		var out []Quote
+		for _, m := range movers {
+			out = append(out, *m.Quote(day))
+		}
+		return out
+	}
+	return &TopMovers{
+		LastUpdated: r.LastUpdated,
+		Gainers:     quotes(r.TopGainers),
+		Losers:      quotes(r.TopLosers),
+		MostActive:  quotes(r.MostActivelyTraded),
+	}
+}
+
+// Quote converts the raw mover fields. The list has no open, high or low,
+// so those are left zero
+func (m Mover) Quote(day string) *Quote {
+	quote := &Quote{
+		Symbol:           m.Ticker,
+		LatestTradingDay: day,
+	}
+	quote.Price, _ = parseFloat(m.Price)
+	quote.Volume, _ = parseInt(m.Volume)
+	quote.Change, _ = parseFloat(m.ChangeAmount)
+	quote.ChangePercent, _ = parseFloat(strings.TrimSuffix(m.ChangePercentage, "%"))
+	quote.PreviousClose = quote.Price - quote.Change
+	return quote
+}
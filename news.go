@@ -1,6 +1,7 @@
 package alphavintage
 
 import (
+	"context"
 	"encoding/json"
 	"strconv"
 )
@@ -17,6 +18,11 @@ type NewsSentimentOptions struct {
 
 // GetNewsSentiment returns news and sentiment data
 func (c *Client) GetNewsSentiment(opts *NewsSentimentOptions) (*NewsSentimentResponse, error) {
+	return c.GetNewsSentimentCtx(context.Background(), opts)
+}
+
+// GetNewsSentimentCtx is like GetNewsSentiment but aborts when ctx is done
+func (c *Client) GetNewsSentimentCtx(ctx context.Context, opts *NewsSentimentOptions) (*NewsSentimentResponse, error) {
 	params := map[string]string{
 		"function": "NEWS_SENTIMENT",
 	}
@@ -42,14 +48,14 @@ func (c *Client) GetNewsSentiment(opts *NewsSentimentOptions) (*NewsSentimentRes
 		}
 	}
 
-	body, err := c.doRequest(params)
+	body, err := c.doRequest(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
 	var result NewsSentimentResponse
 	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, err
+		return nil, avDecodeError(params, err)
 	}
 
 	return &result, nil
@@ -0,0 +1,107 @@
+package alphavintage
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Option configures the HTTP side of a client. The same options work for
+// NewClient, NewFinancialDatasetsClient and NewAIClient
+type Option func(*httpConfig)
+
+type httpConfig struct {
+	baseURL   string
+	userAgent string
+	headers   map[string]string
+	timeout   time.Duration
+	transport http.RoundTripper
+	resty     *resty.Client
+	fixtures  *fixtureTransport
+}
+
+// WithBaseURL points the client at another endpoint, e.g. a proxy or a local fake
+func WithBaseURL(url string) Option {
+	return func(cfg *httpConfig) {
+		cfg.baseURL = url
+	}
+}
+
+// WithUserAgent sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(cfg *httpConfig) {
+		cfg.userAgent = userAgent
+	}
+}
+
+// WithHeader adds a header sent with every request
+func WithHeader(key, value string) Option {
+	return func(cfg *httpConfig) {
+		if cfg.headers == nil {
+			cfg.headers = make(map[string]string)
+		}
+		cfg.headers[key] = value
+	}
+}
+
+// WithTimeout sets the per-request timeout
+func WithTimeout(timeout time.Duration) Option {
+	return func(cfg *httpConfig) {
+		cfg.timeout = timeout
+	}
+}
+
+// WithTransport sets the http.RoundTripper used for requests
+func WithTransport(transport http.RoundTripper) Option {
+	return func(cfg *httpConfig) {
+		cfg.transport = transport
+	}
+}
+
+// WithRestyClient uses a preconfigured resty client. Other options are
+// applied on top of it in place, so WithTimeout, WithTransport,
+// WithFixtures, WithUserAgent and WithHeader also change client. Pass each
+// constructor its own resty client unless they should share those settings
+func WithRestyClient(client *resty.Client) Option {
+	return func(cfg *httpConfig) {
+		cfg.resty = client
+	}
+}
+
+func newHTTPConfig(defaultURL string, opts []Option) httpConfig {
+	cfg := httpConfig{baseURL: defaultURL}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	return cfg
+}
+
+func (cfg httpConfig) newResty(defaultTimeout time.Duration) *resty.Client {
+	r := cfg.resty
+	if r == nil {
+		r = resty.New().SetTimeout(defaultTimeout)
+	}
+	if cfg.timeout > 0 {
+		r.SetTimeout(cfg.timeout)
+	}
+	if cfg.transport != nil {
+		r.SetTransport(cfg.transport)
+	}
+	if cfg.fixtures != nil {
+		// Wrap whatever transport is in place, whichever order the options came in
+		fixtures := *cfg.fixtures
+		fixtures.next = r.GetClient().Transport
+		if prev, ok := fixtures.next.(*fixtureTransport); ok {
+			// A shared resty client already went through WithFixtures,
+			// replace that wrapper instead of stacking another one
+			fixtures.next = prev.next
+		}
+		r.SetTransport(&fixtures)
+	}
+	if cfg.userAgent != "" {
+		r.SetHeader("User-Agent", cfg.userAgent)
+	}
+	r.SetHeaders(cfg.headers)
+	return r
+}
@@ -0,0 +1,208 @@
+package alphavintage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+)
+
+// OptionType is call or put
+type OptionType string
+
+const (
+	OptionCall OptionType = "call"
+	OptionPut  OptionType = "put"
+)
+
+// GetRealtimeOptions returns the full realtime option chain of a symbol
+// (premium). Greeks and implied volatility are only filled in with
+// requireGreeks
+func (c *Client) GetRealtimeOptions(symbol string, requireGreeks bool) ([]OptionContract, error) {
+	return c.GetRealtimeOptionsCtx(context.Background(), symbol, requireGreeks)
+}
+
+// GetRealtimeOptionsCtx is like GetRealtimeOptions but aborts when ctx is done
+func (c *Client) GetRealtimeOptionsCtx(ctx context.Context, symbol string, requireGreeks bool) ([]OptionContract, error) {
+	params := map[string]string{
+		"function": "REALTIME_OPTIONS",
+		"symbol":   symbol,
+	}
+	if requireGreeks {
+		params["require_greeks"] = "true"
+	}
+	return c.getOptions(ctx, params)
+}
+
+// GetHistoricalOptions returns the option chain of a symbol as of the end of
+// date (YYYY-MM-DD), or of the previous trading day when date is empty
+func (c *Client) GetHistoricalOptions(symbol, date string) ([]OptionContract, error) {
+	return c.GetHistoricalOptionsCtx(context.Background(), symbol, date)
+}
+
+// GetHistoricalOptionsCtx is like GetHistoricalOptions but aborts when ctx is done
+func (c *Client) GetHistoricalOptionsCtx(ctx context.Context, symbol, date string) ([]OptionContract, error) {
+	params := map[string]string{
+		"function": "HISTORICAL_OPTIONS",
+		"symbol":   symbol,
+	}
+	if date != "" {
+		params["date"] = date
+	}
+	return c.getOptions(ctx, params)
+}
+
+func (c *Client) getOptions(ctx context.Context, params map[string]string) ([]OptionContract, error) {
+	body, err := c.doRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result OptionsResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, avDecodeError(params, err)
+	}
+
+	// Unknown symbols and non-trading days get an empty chain
+	if len(result.Data) == 0 {
+		message := result.Message
+		if message == "" || message == "success" {
+			message = "no option contracts returned"
+		}
+		kind := ErrInvalidSymbol
+		if params["date"] != "" {
+			kind = ErrAPI
+		}
+		return nil, &APIError{
+			Kind:     kind,
+			Provider: ProviderAlphaVantage,
+			Function: params["function"],
+			Symbol:   params["symbol"],
+			Message:  message,
+		}
+	}
+
+	contracts := make([]OptionContract, 0, len(result.Data))
+	for _, q := range result.Data {
+		contracts = append(contracts, *q.Contract())
+	}
+	return contracts, nil
+}
+
+// Contract converts the raw option fields
+func (q OptionQuote) Contract() *OptionContract {
+	c := &OptionContract{
+		ContractID: q.ContractID,
+		Symbol:     q.Symbol,
+		Expiration: q.Expiration,
+		Type:       OptionType(q.Type),
+		Date:       q.Date,
+	}
+	c.Strike, _ = parseFloat(q.Strike)
+	c.Last, _ = parseFloat(q.Last)
+	c.Mark, _ = parseFloat(q.Mark)
+	c.Bid, _ = parseFloat(q.Bid)
+	c.BidSize, _ = parseInt(q.BidSize)
+	c.Ask, _ = parseFloat(q.Ask)
+	c.AskSize, _ = parseInt(q.AskSize)
+	c.Volume, _ = parseInt(q.Volume)
+	c.OpenInterest, _ = parseInt(q.OpenInterest)
+	c.ImpliedVolatility, _ = parseFloat(q.ImpliedVolatility)
+	c.Delta, _ = parseFloat(q.Delta)
+	c.Gamma, _ = parseFloat(q.Gamma)
+	c.Theta, _ = parseFloat(q.Theta)
+	c.Vega, _ = parseFloat(q.Vega)
+	c.Rho, _ = parseFloat(q.Rho)
+	return c
+}
+
+// OptionExpiry holds the contracts of one expiration date, sorted by strike
+type OptionExpiry struct {
+	Expiration string
+	Calls      []OptionContract
+	Puts       []OptionContract
+}
+
+// GroupByExpiry splits a chain by expiration date, nearest first
+func GroupByExpiry(contracts []OptionContract) []OptionExpiry {
+	byDate := make(map[string]*OptionExpiry)
+	for _, c := range contracts {
+		e, ok := byDate[c.Expiration]
+		if !ok {
+			e = &OptionExpiry{Expiration: c.Expiration}
+			byDate[c.Expiration] = e
+		}
+		if c.Type == OptionPut {
+			e.Puts = append(e.Puts, c)
+		} else {
+			e.Calls = append(e.Calls, c)
+		}
+	}
+
+	expiries := make([]OptionExpiry, 0, len(byDate))
+	for _, e := range byDate {
+		sort.Slice(e.Calls, func(i, j int) bool { return e.Calls[i].Strike < e.Calls[j].Strike })
+		sort.Slice(e.Puts, func(i, j int) bool { return e.Puts[i].Strike < e.Puts[j].Strike })
+		expiries = append(expiries, *e)
+	}
+	sort.Slice(expiries, func(i, j int) bool {
+		return expiries[i].Expiration < expiries[j].Expiration
+	})
+	return expiries
+}
+
+// ATMStrike is the at-the-money strike of one expiration
+type ATMStrike struct {
+	Expiration string
+	Underlying float64 // Price the strike was picked against
+	Strike     float64
+	Call       *OptionContract // nil if the strike has no call
+	Put        *OptionContract // nil if the strike has no put
+}
+
+// ATM returns the strike closest to price with its call and put
+func (e OptionExpiry) ATM(price float64) ATMStrike {
+	atm := ATMStrike{Expiration: e.Expiration, Underlying: price, Strike: math.NaN()}
+	for _, contracts := range [][]OptionContract{e.Calls, e.Puts} {
+		for _, c := range contracts {
+			if math.IsNaN(atm.Strike) || math.Abs(c.Strike-price) < math.Abs(atm.Strike-price) {
+				atm.Strike = c.Strike
+			}
+		}
+	}
+	for i := range e.Calls {
+		if e.Calls[i].Strike == atm.Strike {
+			atm.Call = &e.Calls[i]
+		}
+	}
+	for i := range e.Puts {
+		if e.Puts[i].Strike == atm.Strike {
+			atm.Put = &e.Puts[i]
+		}
+	}
+	return atm
+}
+
+// ATMStrikes returns the at-the-money strike of every expiration, relative
+// to the latest close in daily
+func ATMStrikes(expiries []OptionExpiry, daily *TimeSeriesDailyResponse) ([]ATMStrike, error) {
+	dates := GetSortedDates(daily)
+	if len(dates) == 0 {
+		return nil, fmt.Errorf("no daily data")
+	}
+	last := dates[len(dates)-1]
+	price, err := parseFloat(daily.TimeSeries[last].Close)
+	if err != nil {
+		return nil, fmt.Errorf("invalid close on %s: %w", last, err)
+	}
+
+	var strikes []ATMStrike
+	for _, e := range expiries {
+		if len(e.Calls) == 0 && len(e.Puts) == 0 {
+			continue
+		}
+		strikes = append(strikes, e.ATM(price))
+	}
+	return strikes, nil
+}
@@ -0,0 +1,114 @@
+package alphavintage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// optionsTarget is one of the three constructors under test
+type optionsTarget struct {
+	name  string
+	resty *resty.Client
+	send  func() error
+}
+
+func optionsTargets(opts ...Option) []optionsTarget {
+	av := NewClient("av-key", opts...)
+	fd := NewFinancialDatasetsClient("fd-key", opts...)
+	ai := NewAIClient(AIConfig{APIKey: "or-key"}, opts...)
+	return []optionsTarget{
+		{"Client", av.resty, func() error {
+			_, err := av.doRequest(context.Background(), map[string]string{"function": "OVERVIEW"})
+			return err
+		}},
+		{"FinancialDatasetsClient", fd.resty, func() error {
+			_, err := fd.doRequest(context.Background(), "/prices", map[string]string{"ticker": "IBM"})
+			return err
+		}},
+		{"AIClient", ai.resty, func() error {
+			_, err := ai.chat(context.Background(), "hello")
+			return err
+		}},
+	}
+}
+
+func TestOptions(t *testing.T) {
+	var got *http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r
+		fmt.Fprint(w, `{"choices": [{"message": {"content": "ok"}}]}`)
+	}))
+	defer srv.Close()
+
+	targets := optionsTargets(
+		WithBaseURL(srv.URL+"/proxy"),
+		WithHeader("X-Trace", "abc"),
+		WithUserAgent("alphavintage-test"),
+		WithTimeout(7*time.Second),
+	)
+	for _, target := range targets {
+		t.Run(target.name, func(t *testing.T) {
+			got = nil
+			if err := target.send(); err != nil {
+				t.Fatal(err)
+			}
+			if got == nil || !strings.HasPrefix(got.URL.Path, "/proxy") {
+				t.Fatalf("request did not reach the base URL")
+			}
+			if h := got.Header.Get("X-Trace"); h != "abc" {
+				t.Fatalf("X-Trace = %q; want abc", h)
+			}
+			if ua := got.Header.Get("User-Agent"); ua != "alphavintage-test" {
+				t.Fatalf("User-Agent = %q; want alphavintage-test", ua)
+			}
+			if timeout := target.resty.GetClient().Timeout; timeout != 7*time.Second {
+				t.Fatalf("timeout = %v; want 7s", timeout)
+			}
+		})
+	}
+}
+
+func TestOptionsDefaults(t *testing.T) {
+	want := map[string]time.Duration{
+		"Client":                  30 * time.Second,
+		"FinancialDatasetsClient": 30 * time.Second,
+		"AIClient":                60 * time.Second,
+	}
+	for _, target := range optionsTargets() {
+		if timeout := target.resty.GetClient().Timeout; timeout != want[target.name] {
+			t.Errorf("%s timeout = %v; want %v", target.name, timeout, want[target.name])
+		}
+	}
+
+	if c := NewClient("key"); c.baseURL != baseURL {
+		t.Errorf("Client base URL = %q; want %q", c.baseURL, baseURL)
+	}
+	if c := NewFinancialDatasetsClient("key"); c.baseURL != fdBaseURL {
+		t.Errorf("FinancialDatasetsClient base URL = %q; want %q", c.baseURL, fdBaseURL)
+	}
+	if c := NewAIClient(AIConfig{}); c.url != openRouterURL {
+		t.Errorf("AIClient URL = %q; want %q", c.url, openRouterURL)
+	}
+}
+
+func TestOptionsSharedRestyFixtures(t *testing.T) {
+	shared := resty.New()
+	dir := t.TempDir()
+	NewClient("a", WithRestyClient(shared), WithFixtures(dir, FixtureReplay))
+	NewClient("b", WithRestyClient(shared), WithFixtures(dir, FixtureReplay))
+
+	fixtures, ok := shared.GetClient().Transport.(*fixtureTransport)
+	if !ok {
+		t.Fatalf("transport = %T; want *fixtureTransport", shared.GetClient().Transport)
+	}
+	if _, stacked := fixtures.next.(*fixtureTransport); stacked {
+		t.Fatal("fixture transports stacked on the shared resty client")
+	}
+}
@@ -0,0 +1,119 @@
+package alphavintage
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// maxBulkSymbols is the most symbols REALTIME_BULK_QUOTES accepts per call
+const maxBulkSymbols = 100
+
+// GetQuote returns the latest price and change for a symbol
+func (c *Client) GetQuote(symbol string) (*Quote, error) {
+	return c.GetQuoteCtx(context.Background(), symbol)
+}
+
+// GetQuoteCtx is like GetQuote but aborts when ctx is done
+func (c *Client) GetQuoteCtx(ctx context.Context, symbol string) (*Quote, error) {
+	params := map[string]string{
+		"function": "GLOBAL_QUOTE",
+		"symbol":   symbol,
+	}
+
+	body, err := c.doRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result GlobalQuoteResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, avDecodeError(params, err)
+	}
+
+	// Unknown symbols get an empty "Global Quote" object instead of an error
+	if result.GlobalQuote.Symbol == "" {
+		return nil, &APIError{
+			Kind:     ErrInvalidSymbol,
+			Provider: ProviderAlphaVantage,
+			Function: params["function"],
+			Symbol:   symbol,
+			Message:  "no quote returned",
+		}
+	}
+
+	return result.GlobalQuote.Quote(), nil
+}
+
+// GetBulkQuotes returns realtime quotes for many symbols (premium endpoint).
+// Symbols are sent 100 per request; quotes come back in the API's order and
+// symbols the API doesn't know are left out
+func (c *Client) GetBulkQuotes(symbols []string) ([]Quote, error) {
+	return c.GetBulkQuotesCtx(context.Background(), symbols)
+}
+
+// GetBulkQuotesCtx is like GetBulkQuotes but aborts when ctx is done
+func (c *Client) GetBulkQuotesCtx(ctx context.Context, symbols []string) ([]Quote, error) {
+	var quotes []Quote
+	for start := 0; start < len(symbols); start += maxBulkSymbols {
+		end := start + maxBulkSymbols
+		if end > len(symbols) {
+			end = len(symbols)
+		}
+
+		params := map[string]string{
+			"function": "REALTIME_BULK_QUOTES",
+			"symbol":   strings.Join(symbols[start:end], ","),
+		}
+
+		body, err := c.doRequest(ctx, params)
+		if err != nil {
+			return nil, err
+		}
+
+		var result BulkQuotesResponse
+		if err := json.Unmarshal(body, &result); err != nil {
+			return nil, avDecodeError(params, err)
+		}
+
+		for _, q := range result.Data {
+			quotes = append(quotes, *q.Quote())
+		}
+	}
+	return quotes, nil
+}
+
+// Quote converts the raw GLOBAL_QUOTE fields
+func (q GlobalQuote) Quote() *Quote {
+	quote := &Quote{
+		Symbol:           q.Symbol,
+		LatestTradingDay: q.LatestTradingDay,
+	}
+	quote.Open, _ = parseFloat(q.Open)
+	quote.High, _ = parseFloat(q.High)
+	quote.Low, _ = parseFloat(q.Low)
+	quote.Price, _ = parseFloat(q.Price)
+	quote.Volume, _ = parseInt(q.Volume)
+	quote.PreviousClose, _ = parseFloat(q.PreviousClose)
+	quote.Change, _ = parseFloat(q.Change)
+	quote.ChangePercent, _ = parseFloat(strings.TrimSuffix(q.ChangePercent, "%"))
+	return quote
+}
+
+// Quote converts the raw REALTIME_BULK_QUOTES fields. Extended hours prices
+// are not included
+func (q BulkQuote) Quote() *Quote {
+	quote := &Quote{Symbol: q.Symbol}
+	if len(q.Timestamp) >= 10 {
+		quote.LatestTradingDay = q.Timestamp[:10]
+	}
+	quote.Open, _ = parseFloat(q.Open)
+	quote.High, _ = parseFloat(q.High)
+	quote.Low, _ = parseFloat(q.Low)
+	quote.Price, _ = parseFloat(q.Close)
+	quote.Volume, _ = parseInt(q.Volume)
+	quote.PreviousClose, _ = parseFloat(q.PreviousClose)
+	quote.Change, _ = parseFloat(q.Change)
+	quote.ChangePercent, _ = parseFloat(strings.TrimSuffix(q.ChangePercent, "%"))
+	return quote
+}
@@ -0,0 +1,116 @@
+package alphavintage
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrDailyBudgetExhausted is returned once the RateLimitPolicy daily budget is used up.
+// It matches ErrRateLimited with errors.Is
+var ErrDailyBudgetExhausted = fmt.Errorf("%w: daily budget exhausted", ErrRateLimited)
+
+// RateLimitPolicy configures client-side throttling
+type RateLimitPolicy struct {
+	RequestsPerMinute int  // 0 = no per-minute limit
+	RequestsPerDay    int  // 0 = no daily budget, resets at 00:00 UTC
+	FailFast          bool // Return ErrRateLimited instead of waiting for a free slot
+}
+
+// FreeTierRateLimit returns the Alpha Vantage free plan quotas
+func FreeTierRateLimit() RateLimitPolicy {
+	return RateLimitPolicy{
+		RequestsPerMinute: 5,
+		RequestsPerDay:    25,
+	}
+}
+
+// RateLimiter enforces a RateLimitPolicy. It is safe for concurrent use
+// and can be shared by several clients that use the same API key
+type RateLimiter struct {
+	policy RateLimitPolicy
+
+	mu       sync.Mutex
+	recent   []time.Time // Request times within the last minute, oldest first
+	day      time.Time   // UTC day the daily count belongs to
+	dayCount int
+}
+
+// NewRateLimiter creates a rate limiter for the given policy
+func NewRateLimiter(policy RateLimitPolicy) *RateLimiter {
+	return &RateLimiter{policy: policy}
+}
+
+// Wait blocks until a request may be sent, ctx is done, or the daily budget
+// is exhausted. With FailFast it never blocks.
+// The daily budget never waits for the next day; it fails with ErrDailyBudgetExhausted
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		delay, err := l.reserve(time.Now())
+		if err != nil || delay == 0 {
+			return err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes a slot if one is free, otherwise reports how long to wait.
+// With FailFast the wait comes back together with the error
+func (l *RateLimiter) reserve(now time.Time) (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rollDay(now)
+	if l.policy.RequestsPerDay > 0 && l.dayCount >= l.policy.RequestsPerDay {
+		return 0, ErrDailyBudgetExhausted
+	}
+
+	if l.policy.RequestsPerMinute > 0 {
+		cutoff := now.Add(-time.Minute)
+		for len(l.recent) > 0 && !l.recent[0].After(cutoff) {
+			l.recent = l.recent[1:]
+		}
+		if len(l.recent) >= l.policy.RequestsPerMinute {
+			delay := l.recent[0].Add(time.Minute).Sub(now)
+			if l.policy.FailFast {
+				return delay, fmt.Errorf("%w: client-side limit of %d requests per minute reached",
+					ErrRateLimited, l.policy.RequestsPerMinute)
+			}
+			return delay, nil
+		}
+		l.recent = append(l.recent, now)
+	}
+
+	l.dayCount++
+	return 0, nil
+}
+
+func (l *RateLimiter) rollDay(now time.Time) {
+	day := now.UTC().Truncate(24 * time.Hour)
+	if !day.Equal(l.day) {
+		l.day = day
+		l.dayCount = 0
+	}
+}
+
+// RemainingToday returns how many requests are left in today's budget,
+// or -1 when the policy has no daily budget
+func (l *RateLimiter) RemainingToday() int {
+	if l.policy.RequestsPerDay <= 0 {
+		return -1
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.rollDay(time.Now())
+	return l.policy.RequestsPerDay - l.dayCount
+}
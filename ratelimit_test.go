@@ -0,0 +1,126 @@
+package alphavintage
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+var day0 = time.Date(2024, 12, 20, 15, 0, 0, 0, time.UTC)
+
+func TestRateLimiterMinuteWindow(t *testing.T) {
+	l := NewRateLimiter(RateLimitPolicy{RequestsPerMinute: 2})
+
+	for _, offset := range []time.Duration{0, 10 * time.Second} {
+		if d, err := l.reserve(day0.Add(offset)); d != 0 || err != nil {
+			t.Fatalf("reserve at +%v = %v, %v; want a free slot", offset, d, err)
+		}
+	}
+
+	// Third request waits until the oldest one leaves the window
+	if d, err := l.reserve(day0.Add(20 * time.Second)); d != 40*time.Second || err != nil {
+		t.Fatalf("reserve at +20s = %v, %v; want 40s wait", d, err)
+	}
+
+	// Exactly one minute after the first request its slot is free again
+	if d, err := l.reserve(day0.Add(time.Minute)); d != 0 || err != nil {
+		t.Fatalf("reserve at +1m = %v, %v; want a free slot", d, err)
+	}
+	if len(l.recent) != 2 {
+		t.Fatalf("recent = %v; want the +10s and +1m requests", l.recent)
+	}
+
+	if d, err := l.reserve(day0.Add(time.Minute + 5*time.Second)); d != 5*time.Second || err != nil {
+		t.Fatalf("reserve at +1m5s = %v, %v; want 5s wait", d, err)
+	}
+}
+
+func TestRateLimiterFailFast(t *testing.T) {
+	l := NewRateLimiter(RateLimitPolicy{RequestsPerMinute: 1, FailFast: true})
+
+	if _, err := l.reserve(day0); err != nil {
+		t.Fatalf("first reserve: %v", err)
+	}
+	d, err := l.reserve(day0.Add(time.Second))
+	if d != 59*time.Second || !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("second reserve = %v, %v; want ErrRateLimited and the 59s until a slot frees up", d, err)
+	}
+	if errors.Is(err, ErrDailyBudgetExhausted) {
+		t.Fatalf("per-minute error %v matches ErrDailyBudgetExhausted", err)
+	}
+}
+
+func TestRateLimiterDailyBudget(t *testing.T) {
+	l := NewRateLimiter(RateLimitPolicy{RequestsPerDay: 2})
+
+	for i := 0; i < 2; i++ {
+		if _, err := l.reserve(day0.Add(time.Duration(i) * time.Hour)); err != nil {
+			t.Fatalf("reserve %d: %v", i, err)
+		}
+	}
+	if _, err := l.reserve(day0.Add(8*time.Hour + 59*time.Minute)); !errors.Is(err, ErrDailyBudgetExhausted) {
+		t.Fatalf("reserve before midnight = %v; want ErrDailyBudgetExhausted", err)
+	}
+	if !errors.Is(ErrDailyBudgetExhausted, ErrRateLimited) {
+		t.Fatal("ErrDailyBudgetExhausted does not match ErrRateLimited")
+	}
+
+	// 00:00 UTC starts a new budget
+	if _, err := l.reserve(day0.Add(9 * time.Hour)); err != nil {
+		t.Fatalf("reserve after midnight: %v", err)
+	}
+	if l.dayCount != 1 {
+		t.Fatalf("dayCount = %d after rollover; want 1", l.dayCount)
+	}
+}
+
+func TestRateLimiterRemainingToday(t *testing.T) {
+	if got := NewRateLimiter(RateLimitPolicy{RequestsPerMinute: 5}).RemainingToday(); got != -1 {
+		t.Fatalf("RemainingToday without daily budget = %d; want -1", got)
+	}
+
+	l := NewRateLimiter(RateLimitPolicy{RequestsPerDay: 3})
+	if got := l.RemainingToday(); got != 3 {
+		t.Fatalf("RemainingToday = %d; want 3", got)
+	}
+	now := time.Now()
+	l.reserve(now)
+	l.reserve(now)
+	if got := l.RemainingToday(); got != 1 {
+		t.Fatalf("RemainingToday after 2 requests = %d; want 1", got)
+	}
+
+	// Requests counted on an earlier day no longer reduce the budget
+	l.reserve(now.Add(-48 * time.Hour))
+	if got := l.RemainingToday(); got != 3 {
+		t.Fatalf("RemainingToday after a request on an earlier day = %d; want 3", got)
+	}
+}
+
+func TestRateLimiterConcurrentReserve(t *testing.T) {
+	l := NewRateLimiter(RateLimitPolicy{RequestsPerMinute: 100, RequestsPerDay: 10})
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	granted := 0
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if d, err := l.reserve(day0); d == 0 && err == nil {
+				mu.Lock()
+				granted++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if granted != 10 {
+		t.Fatalf("granted %d slots; want the daily budget of 10", granted)
+	}
+	if len(l.recent) != 10 {
+		t.Fatalf("recent holds %d requests; want 10", len(l.recent))
+	}
+}
@@ -558,6 +558,66 @@ func (rb *ReportBuilder) AddCashFlowChart(data *CashFlowResponse, opts ChartOpti
 	return rb
 }
 
+// AddIncomeStatementChart generates and adds a revenue and net income chart
+func (rb *ReportBuilder) AddIncomeStatementChart(data *IncomeStatementResponse, opts ChartOptions) *ReportBuilder {
+	if data == nil || len(data.AnnualReports) == 0 {
+		return rb
+	}
+	if opts.Width == 0 {
+		opts.Width = 900
+	}
+	if opts.Height == 0 {
+		opts.Height = 450
+	}
+
+	var buf bytes.Buffer
+	if err := GenerateIncomeStatementChart(data, &buf, opts); err != nil {
+		rb.AddText(fmt.Sprintf("Error generating chart: %v", err))
+		return rb
+	}
+
+	imgWidth := rb.contentWidth()
+	imgHeight := imgWidth * float64(opts.Height) / float64(opts.Width)
+	rb.addChartImage(buf.Bytes(), "income", imgWidth, imgHeight)
+	return rb
+}
+
+// AddEconomicSeries adds the latest value of an economic indicator or
+// commodity series, its change from the previous observation and a chart
+func (rb *ReportBuilder) AddEconomicSeries(series *EconomicSeries, opts ChartOptions) *ReportBuilder {
+	if series == nil || len(series.Data) == 0 {
+		return rb
+	}
+
+	dates, values := series.Values()
+	if n := len(values); n > 0 {
+		rb.AddKeyValue(series.Name, fmt.Sprintf("%.2f %s (%s)", values[n-1], series.Unit, dates[n-1].Format("2006-01-02")))
+		if n > 1 {
+			rb.AddKeyValue("Previous", fmt.Sprintf("%.2f (%s)", values[n-2], dates[n-2].Format("2006-01-02")))
+			rb.AddKeyValue("Change", fmt.Sprintf("%+.2f", values[n-1]-values[n-2]))
+		}
+		rb.pdf.Ln(3)
+	}
+
+	if opts.Width == 0 {
+		opts.Width = 900
+	}
+	if opts.Height == 0 {
+		opts.Height = 400
+	}
+
+	var buf bytes.Buffer
+	if err := GenerateEconomicChart(series, &buf, opts); err != nil {
+		rb.AddText(fmt.Sprintf("Error generating chart: %v", err))
+		return rb
+	}
+
+	imgWidth := rb.contentWidth()
+	imgHeight := imgWidth * float64(opts.Height) / float64(opts.Width)
+	rb.addChartImage(buf.Bytes(), "economic", imgWidth, imgHeight)
+	return rb
+}
+
 // AddIntradayChart generates and adds an intraday chart
 func (rb *ReportBuilder) AddIntradayChart(data *TimeSeriesIntradayResponse, opts ChartOptions) *ReportBuilder {
 	if data == nil || len(data.TimeSeries) == 0 {
@@ -694,6 +754,83 @@ func (rb *ReportBuilder) AddMarketStatusSummary(data *MarketStatusResponse) *Rep
 	return rb
 }
 
+// AddMarketBriefing adds a market open briefing: which venues are open, the
+// status table and the top count gainers, losers and most active tickers.
+// count defaults to 5, which keeps the briefing on one page
+func (rb *ReportBuilder) AddMarketBriefing(status *MarketStatusResponse, movers *TopMovers, count int) *ReportBuilder {
+	if count <= 0 {
+		count = 5
+	}
+	rb.AddHeading("Market Open Briefing")
+	if movers != nil && movers.LastUpdated != "" {
+		rb.AddItalicText("Movers as of " + movers.LastUpdated)
+	}
+	if status != nil {
+		var open []string
+		for _, m := range status.OpenMarkets() {
+			open = append(open, fmt.Sprintf("%s %s", m.Region, m.MarketType))
+		}
+		if len(open) == 0 {
+			open = []string{"none"}
+		}
+		rb.AddKeyValue("Open Now", strings.Join(open, ", "))
+		rb.pdf.Ln(2)
+		rb.AddMarketStatusSummary(status)
+	}
+	if movers == nil {
+		return rb
+	}
+	for _, list := range []struct {
+		title  string
+		quotes []Quote
+	}{
+		{"Top Gainers", movers.Gainers},
+		{"Top Losers", movers.Losers},
+		{"Most Active", movers.MostActive},
+	} {
+		if len(list.quotes) == 0 {
+			continue
+		}
+		n := count
+		if n > len(list.quotes) {
+			n = len(list.quotes)
+		}
+		var rows [][]string
+		for _, q := range list.quotes[:n] {
+			rows = append(rows, []string{
+				q.Symbol,
+				fmt.Sprintf("$%.2f", q.Price),
+				fmt.Sprintf("%+.2f", q.Change),
+				fmt.Sprintf("%+.2f%%", q.ChangePercent),
+				formatVolume(float64(q.Volume)),
+			})
+		}
+		rb.AddBoldText(list.title)
+		rb.AddTable([]string{"Symbol", "Price", "Change", "Change %", "Volume"}, rows)
+	}
+	return rb
+}
+
+// AddCompanyOverview adds company profile and valuation key metrics
+func (rb *ReportBuilder) AddCompanyOverview(data *CompanyOverview) *ReportBuilder {
+	if data == nil {
+		return rb
+	}
+	rb.AddKeyValue("Company", fmt.Sprintf("%s (%s)", data.Name, data.Symbol))
+	rb.AddKeyValue("Exchange", data.Exchange)
+	rb.AddKeyValue("Sector", data.Sector)
+	rb.AddKeyValue("Industry", data.Industry)
+	rb.AddKeyValue("Market Cap", formatCurrency(data.MarketCapitalization))
+	rb.AddKeyValue("P/E Ratio", orNA(data.PERatio))
+	rb.AddKeyValue("PEG Ratio", orNA(data.PEGRatio))
+	rb.AddKeyValue("Beta", orNA(data.Beta))
+	rb.AddKeyValue("Dividend Yield", formatPercent(data.DividendYield))
+	rb.AddKeyValue("52-Week Range", fmt.Sprintf("$%s - $%s", orNA(data.Week52Low), orNA(data.Week52High)))
+	rb.AddKeyValue("Analyst Target", "$"+orNA(data.AnalystTargetPrice))
+	rb.pdf.Ln(5)
+	return rb
+}
+
 // AddBalanceSheetSummary adds balance sheet key metrics
 func (rb *ReportBuilder) AddBalanceSheetSummary(data *BalanceSheetResponse) *ReportBuilder {
 	if data == nil || len(data.AnnualReports) == 0 {
@@ -730,6 +867,34 @@ func (rb *ReportBuilder) AddEarningsSummary(data *EarningsResponse, years int) *
 	return rb
 }
 
+// AddIncomeStatementSummary adds revenue, net income and margins for recent years
+func (rb *ReportBuilder) AddIncomeStatementSummary(data *IncomeStatementResponse, years int) *ReportBuilder {
+	if data == nil || len(data.AnnualReports) == 0 {
+		return rb
+	}
+	if years <= 0 || years > len(data.AnnualReports) {
+		years = len(data.AnnualReports)
+	}
+	if years > 5 {
+		years = 5
+	}
+	var rows [][]string
+	for i := 0; i < years; i++ {
+		r := data.AnnualReports[i]
+		m := r.Margins()
+		rows = append(rows, []string{
+			r.FiscalDateEnding,
+			formatCurrency(r.TotalRevenue),
+			fmt.Sprintf("%.1f%%", m.Gross*100),
+			fmt.Sprintf("%.1f%%", m.Operating*100),
+			formatCurrency(r.NetIncome),
+			fmt.Sprintf("%.1f%%", m.Net*100),
+		})
+	}
+	rb.AddTable([]string{"Fiscal Year End", "Revenue", "Gross Margin", "Op. Margin", "Net Income", "Net Margin"}, rows)
+	return rb
+}
+
 // AddCashFlowSummary adds cash flow key metrics
 func (rb *ReportBuilder) AddCashFlowSummary(data *CashFlowResponse) *ReportBuilder {
 	if data == nil || len(data.AnnualReports) == 0 {
@@ -746,6 +911,22 @@ func (rb *ReportBuilder) AddCashFlowSummary(data *CashFlowResponse) *ReportBuild
 	return rb
 }
 
+// AddUpcomingEvents adds a table of earnings reports and IPOs for the
+// symbols in watchlist (all of them when watchlist is empty)
+func (rb *ReportBuilder) AddUpcomingEvents(earnings []EarningsCalendarEntry, ipos []IPOCalendarEntry, watchlist []string) *ReportBuilder {
+	events := UpcomingEvents(earnings, ipos, watchlist)
+	if len(events) == 0 {
+		rb.AddItalicText("No upcoming earnings or IPOs")
+		return rb
+	}
+	var rows [][]string
+	for _, e := range events {
+		rows = append(rows, []string{e.Date, e.Symbol, e.Event, e.Detail})
+	}
+	rb.AddTable([]string{"Date", "Symbol", "Event", "Detail"}, rows)
+	return rb
+}
+
 // AddTimestamp adds generation timestamp
 func (rb *ReportBuilder) AddTimestamp() *ReportBuilder {
 	rb.pdf.SetFont(rb.fontFamily, "I", 10)
@@ -831,6 +1012,26 @@ func formatCurrency(value string) string {
 	return result
 }
 
+// formatPercent formats a fraction such as "0.0251" as "2.51%"
+func formatPercent(value string) string {
+	if value == "" || value == "None" || value == "-" {
+		return "N/A"
+	}
+	var num float64
+	if _, err := fmt.Sscanf(value, "%f", &num); err != nil {
+		return "N/A"
+	}
+	return fmt.Sprintf("%.2f%%", num*100)
+}
+
+// orNA returns value, or "N/A" when Alpha Vantage has no value
+func orNA(value string) string {
+	if value == "" || value == "None" || value == "-" {
+		return "N/A"
+	}
+	return value
+}
+
 // AddAISummary adds an AI-generated analysis summary section
 func (rb *ReportBuilder) AddAISummary(summary *AnalysisSummary) *ReportBuilder {
 	if summary == nil {
@@ -1042,6 +1243,25 @@ func (rb *ReportBuilder) AddFDInsiderTrades(trades []FDInsiderTrade, count int)
 	return rb
 }
 
+// AddInsiderActivity adds net insider buying and selling from
+// SummarizeInsiderTrades
+func (rb *ReportBuilder) AddInsiderActivity(activity InsiderActivity) *ReportBuilder {
+	if activity.Insiders == 0 {
+		rb.AddItalicText("No insider trades in this period")
+		return rb
+	}
+	rb.AddKeyValue("Period", fmt.Sprintf("%s to %s", activity.From, activity.To))
+	rb.AddKeyValue("Insiders", fmt.Sprintf("%d", activity.Insiders))
+	rb.AddKeyValue("Buys", fmt.Sprintf("%d (%.0f shares, %s)", activity.Buys, activity.SharesBought, formatLargeNumber(activity.ValueBought)))
+	rb.AddKeyValue("Sells", fmt.Sprintf("%d (%.0f shares, %s)", activity.Sells, activity.SharesSold, formatLargeNumber(activity.ValueSold)))
+	if activity.Grants > 0 {
+		rb.AddKeyValue("Grants", fmt.Sprintf("%d (%.0f shares)", activity.Grants, activity.SharesGrants))
+	}
+	rb.AddKeyValue("Net", fmt.Sprintf("%s, %s", activity.Direction(), formatLargeNumber(activity.NetValue())))
+	rb.pdf.Ln(5)
+	return rb
+}
+
 // AddFDInstitutionalOwnership adds institutional ownership table
 func (rb *ReportBuilder) AddFDInstitutionalOwnership(ownership []FDInstitutionalOwnership, count int) *ReportBuilder {
 	if len(ownership) == 0 {
@@ -0,0 +1,133 @@
+package alphavintage
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// RetryPolicy configures automatic retries with exponential backoff.
+// Every request the clients make is safe to repeat: Alpha Vantage and
+// Financial Datasets calls are GETs, and OpenRouter chat completions
+// have no side effects besides token usage
+type RetryPolicy struct {
+	MaxAttempts int              // Total attempts including the first, <= 1 disables retries
+	BaseDelay   time.Duration    // Delay before the first retry, doubled for each further retry, 0 = retry at once
+	MaxDelay    time.Duration    // Upper bound for a single delay, 0 = no bound
+	RetryOn     func(error) bool // Decides which errors are retried, nil = IsRetryable
+}
+
+// DefaultRetryPolicy returns a policy that rides out the Alpha Vantage
+// per-minute limit and short outages
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 4,
+		BaseDelay:   5 * time.Second,
+		MaxDelay:    60 * time.Second,
+	}
+}
+
+// IsRetryable reports whether a request that failed with err may succeed
+// if repeated: per-minute rate limits, 5xx/429 responses and network errors.
+// Invalid symbols or keys, premium endpoints, daily quotas, decode errors,
+// missing fixtures and context cancellation are permanent
+func IsRetryable(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, ErrDailyBudgetExhausted) || errors.Is(err, ErrKeysExhausted) ||
+		errors.Is(err, ErrFixtureMissing) {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Kind == ErrRateLimited && !isDailyQuotaMessage(apiErr.Message)
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= 500 || errors.Is(err, ErrRateLimited)
+	}
+
+	var decodeErr *DecodeError
+	if errors.As(err, &decodeErr) {
+		return false
+	}
+
+	// Client-side per-minute limit
+	if errors.Is(err, ErrRateLimited) {
+		return true
+	}
+
+	var netErr net.Error
+	var urlErr *url.Error
+	return errors.As(err, &netErr) || errors.As(err, &urlErr)
+}
+
+// isDailyQuotaMessage spots Alpha Vantage messages about the daily quota,
+// which won't clear within any reasonable backoff
+func isDailyQuotaMessage(message string) bool {
+	lower := strings.ToLower(message)
+	return strings.Contains(lower, "per day") && !strings.Contains(lower, "per minute")
+}
+
+func (p *RetryPolicy) delay(retry int) time.Duration {
+	if p.BaseDelay <= 0 {
+		return 0
+	}
+	limit := p.MaxDelay
+	if limit <= 0 {
+		limit = math.MaxInt64
+	}
+	// Double step by step and stop at the bound, BaseDelay << retry
+	// overflows for large MaxAttempts
+	d := p.BaseDelay
+	for i := 0; i < retry && d < limit; i++ {
+		if d > limit/2 {
+			d = limit
+		} else {
+			d *= 2
+		}
+	}
+	if d > limit {
+		d = limit
+	}
+	// Jitter between half and the full delay so parallel callers spread out
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+// do runs fn until it succeeds, fails permanently or attempts run out.
+// A nil policy runs fn once
+func (p *RetryPolicy) do(ctx context.Context, fn func() ([]byte, error)) ([]byte, error) {
+	body, err := fn()
+	if p == nil {
+		return body, err
+	}
+
+	retryOn := p.RetryOn
+	if retryOn == nil {
+		retryOn = IsRetryable
+	}
+
+	for attempt := 1; err != nil && attempt < p.MaxAttempts && retryOn(err); attempt++ {
+		timer := time.NewTimer(p.delay(attempt - 1))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+		body, err = fn()
+	}
+	return body, err
+}
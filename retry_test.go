@@ -0,0 +1,163 @@
+package alphavintage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestRetryDelayBackoff(t *testing.T) {
+	p := &RetryPolicy{BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+
+	tests := []struct {
+		retry int
+		full  time.Duration
+	}{
+		{0, time.Second},
+		{1, 2 * time.Second},
+		{2, 4 * time.Second},
+		{3, 8 * time.Second},
+		{4, 10 * time.Second},
+		{100, 10 * time.Second},
+	}
+	for _, tt := range tests {
+		for i := 0; i < 50; i++ {
+			if d := p.delay(tt.retry); d < tt.full/2 || d > tt.full {
+				t.Fatalf("delay(%d) = %v; want within [%v, %v]", tt.retry, d, tt.full/2, tt.full)
+			}
+		}
+	}
+}
+
+func TestRetryDelayNoOverflow(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy RetryPolicy
+		min    time.Duration
+		max    time.Duration
+	}{
+		{"bounded", RetryPolicy{BaseDelay: time.Millisecond, MaxDelay: time.Minute}, 30 * time.Second, time.Minute},
+		{"unbounded", RetryPolicy{BaseDelay: time.Millisecond}, time.Hour, time.Duration(1<<63 - 1)},
+		{"base above bound", RetryPolicy{BaseDelay: time.Hour, MaxDelay: time.Minute}, 30 * time.Second, time.Minute},
+		{"no base", RetryPolicy{MaxDelay: time.Minute}, 0, 0},
+		{"no delays", RetryPolicy{}, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, retry := range []int{30, 63, 64, 65, 200} {
+				if d := tt.policy.delay(retry); d < tt.min || d > tt.max {
+					t.Fatalf("delay(%d) = %v; want within [%v, %v]", retry, d, tt.min, tt.max)
+				}
+			}
+		})
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	avErr := func(field, message string) error {
+		return &APIError{Kind: classifyAVMessage(field, message, "IBM"), Provider: ProviderAlphaVantage, Message: message}
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"per-minute note", avErr("Note", "Our standard API call frequency is 5 calls per minute and 500 calls per day."), true},
+		{"daily quota information", avErr("Information", "Our standard API rate limit is 25 requests per day."), false},
+		{"invalid symbol", avErr("Error Message", "Invalid API call."), false},
+		{"invalid key", avErr("Error Message", "the parameter apikey is invalid or missing."), false},
+		{"premium", avErr("Information", "This is a premium endpoint."), false},
+		{"http 503", &HTTPError{StatusCode: 503}, true},
+		{"http 429", &HTTPError{StatusCode: 429}, true},
+		{"http 404", &HTTPError{StatusCode: 404, Symbol: "IBM"}, false},
+		{"decode", &DecodeError{Err: errors.New("unexpected end of JSON input")}, false},
+		{"client-side per-minute limit", fmt.Errorf("%w: client-side limit", ErrRateLimited), true},
+		{"daily budget", ErrDailyBudgetExhausted, false},
+		{"wrapped daily budget", fmt.Errorf("batch: %w", ErrDailyBudgetExhausted), false},
+		{"keys exhausted", ErrKeysExhausted, false},
+		{"fixture missing", fmt.Errorf("%w: GET /query", ErrFixtureMissing), false},
+		{"canceled", context.Canceled, false},
+		{"deadline", fmt.Errorf("request failed: %w", context.DeadlineExceeded), false},
+		{"network", &net.OpError{Op: "dial", Err: errors.New("connection refused")}, true},
+		{"other", errors.New("boom"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryable(tt.err); got != tt.want {
+				t.Fatalf("IsRetryable(%v) = %v; want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetryDo(t *testing.T) {
+	transient := &HTTPError{StatusCode: 503}
+	permanent := &HTTPError{StatusCode: 404, Symbol: "IBM"}
+
+	tests := []struct {
+		name      string
+		policy    *RetryPolicy
+		failures  []error // Returned by the first calls, later calls succeed
+		wantCalls int
+		wantErr   error
+	}{
+		{"nil policy", nil, []error{transient}, 1, transient},
+		{"success", &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}, nil, 1, nil},
+		{"recovers", &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}, []error{transient, transient}, 3, nil},
+		{"attempts run out", &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}, []error{transient, transient, transient}, 3, transient},
+		{"permanent", &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}, []error{permanent}, 1, permanent},
+		{"disabled", &RetryPolicy{MaxAttempts: 1, BaseDelay: time.Millisecond}, []error{transient}, 1, transient},
+		{"custom RetryOn", &RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, RetryOn: func(error) bool { return true }}, []error{permanent}, 2, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			_, err := tt.policy.do(context.Background(), func() ([]byte, error) {
+				calls++
+				if calls <= len(tt.failures) {
+					return nil, tt.failures[calls-1]
+				}
+				return []byte("{}"), nil
+			})
+			if calls != tt.wantCalls {
+				t.Fatalf("calls = %d; want %d", calls, tt.wantCalls)
+			}
+			if err != tt.wantErr {
+				t.Fatalf("err = %v; want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRetryDoStopsOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &RetryPolicy{MaxAttempts: 5, BaseDelay: time.Hour}
+
+	calls := 0
+	done := make(chan error)
+	go func() {
+		_, err := p.do(ctx, func() ([]byte, error) {
+			calls++
+			return nil, &HTTPError{StatusCode: 503}
+		})
+		done <- err
+	}()
+	cancel()
+
+	select {
+	case err := <-done:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("err = %v; want context.Canceled", err)
+		}
+		if calls != 1 {
+			t.Fatalf("calls = %d; want 1", calls)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("do kept waiting after ctx was canceled")
+	}
+}
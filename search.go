@@ -0,0 +1,149 @@
+package alphavintage
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ListingState selects active or delisted symbols in GetListingStatus
+type ListingState string
+
+const (
+	ListingActive   ListingState = "active"
+	ListingDelisted ListingState = "delisted"
+)
+
+// ListingStatusOptions contains options for listing status API
+type ListingStatusOptions struct {
+	Date  string       // YYYY-MM-DD, empty = latest trading day
+	State ListingState // Empty = active
+}
+
+// SearchSymbols returns the best matching symbols for a company name or
+// partial ticker, highest match score first
+func (c *Client) SearchSymbols(keywords string) ([]SymbolMatch, error) {
+	return c.SearchSymbolsCtx(context.Background(), keywords)
+}
+
+// SearchSymbolsCtx is like SearchSymbols but aborts when ctx is done
+func (c *Client) SearchSymbolsCtx(ctx context.Context, keywords string) ([]SymbolMatch, error) {
+	params := map[string]string{
+		"function": "SYMBOL_SEARCH",
+		"keywords": keywords,
+	}
+
+	body, err := c.doRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result SymbolSearchResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, avDecodeError(params, err)
+	}
+
+	return result.BestMatches, nil
+}
+
+// Score returns the match score between 0 and 1
+func (m SymbolMatch) Score() float64 {
+	score, _ := parseFloat(m.MatchScore)
+	return score
+}
+
+// GetListingStatus returns the active or delisted US stocks and ETFs,
+// as of opts.Date when set
+func (c *Client) GetListingStatus(opts *ListingStatusOptions) ([]Listing, error) {
+	return c.GetListingStatusCtx(context.Background(), opts)
+}
+
+// GetListingStatusCtx is like GetListingStatus but aborts when ctx is done
+func (c *Client) GetListingStatusCtx(ctx context.Context, opts *ListingStatusOptions) ([]Listing, error) {
+	params := map[string]string{
+		"function": "LISTING_STATUS",
+	}
+
+	if opts != nil {
+		if opts.Date != "" {
+			params["date"] = opts.Date
+		}
+		if opts.State != "" {
+			params["state"] = string(opts.State)
+		}
+	}
+
+	body, err := c.doRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := parseCSV(body)
+	if err != nil {
+		return nil, avDecodeError(params, err)
+	}
+
+	listings := make([]Listing, 0, len(rows))
+	for _, row := range rows {
+		listings = append(listings, Listing{
+			Symbol:        row["symbol"],
+			Name:          row["name"],
+			Exchange:      row["exchange"],
+			AssetType:     row["assetType"],
+			IPODate:       row["ipoDate"],
+			DelistingDate: row["delistingDate"],
+			Status:        row["status"],
+		})
+	}
+	return listings, nil
+}
+
+// FindListing returns the listing for symbol, or nil if it isn't in listings
+func FindListing(listings []Listing, symbol string) *Listing {
+	for i := range listings {
+		if strings.EqualFold(listings[i].Symbol, symbol) {
+			return &listings[i]
+		}
+	}
+	return nil
+}
+
+// parseCSV reads a CSV response into one map per row, keyed by the header
+func parseCSV(body []byte) ([]map[string]string, error) {
+	r := csv.NewReader(bytes.NewReader(body))
+	r.FieldsPerRecord = -1
+
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(header) == 0 || header[0] == "" || strings.HasPrefix(header[0], "{") {
+		return nil, fmt.Errorf("expected CSV header, got %q", strings.Join(header, ","))
+	}
+
+	var rows []map[string]string
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		row := make(map[string]string, len(header))
+		for i, name := range header {
+			if i < len(record) {
+				row[name] = record[i]
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
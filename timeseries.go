@@ -1,8 +1,11 @@
 package alphavintage
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
 )
 
 // OutputSize represents the output size option
@@ -26,6 +29,11 @@ const (
 
 // GetTimeSeriesDaily returns daily OHLCV data for a symbol
 func (c *Client) GetTimeSeriesDaily(symbol string, outputSize OutputSize) (*TimeSeriesDailyResponse, error) {
+	return c.GetTimeSeriesDailyCtx(context.Background(), symbol, outputSize)
+}
+
+// GetTimeSeriesDailyCtx is like GetTimeSeriesDaily but aborts when ctx is done
+func (c *Client) GetTimeSeriesDailyCtx(ctx context.Context, symbol string, outputSize OutputSize) (*TimeSeriesDailyResponse, error) {
 	params := map[string]string{
 		"function": "TIME_SERIES_DAILY",
 		"symbol":   symbol,
@@ -34,14 +42,14 @@ func (c *Client) GetTimeSeriesDaily(symbol string, outputSize OutputSize) (*Time
 		params["outputsize"] = string(outputSize)
 	}
 
-	body, err := c.doRequest(params)
+	body, err := c.doRequest(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
 	var result TimeSeriesDailyResponse
 	if err := json.Unmarshal(body, &result); err != nil {
-		return nil, err
+		return nil, avDecodeError(params, err)
 	}
 
 	return &result, nil
@@ -49,6 +57,11 @@ func (c *Client) GetTimeSeriesDaily(symbol string, outputSize OutputSize) (*Time
 
 // GetTimeSeriesIntraday returns intraday OHLCV data for a symbol
 func (c *Client) GetTimeSeriesIntraday(symbol string, interval Interval, outputSize OutputSize) (*TimeSeriesIntradayResponse, error) {
+	return c.GetTimeSeriesIntradayCtx(context.Background(), symbol, interval, outputSize)
+}
+
+// GetTimeSeriesIntradayCtx is like GetTimeSeriesIntraday but aborts when ctx is done
+func (c *Client) GetTimeSeriesIntradayCtx(ctx context.Context, symbol string, interval Interval, outputSize OutputSize) (*TimeSeriesIntradayResponse, error) {
 	params := map[string]string{
 		"function": "TIME_SERIES_INTRADAY",
 		"symbol":   symbol,
@@ -58,7 +71,7 @@ func (c *Client) GetTimeSeriesIntraday(symbol string, interval Interval, outputS
 		params["outputsize"] = string(outputSize)
 	}
 
-	body, err := c.doRequest(params)
+	body, err := c.doRequest(ctx, params)
 	if err != nil {
 		return nil, err
 	}
@@ -66,25 +79,220 @@ func (c *Client) GetTimeSeriesIntraday(symbol string, interval Interval, outputS
 	// Dynamic key based on interval
 	var raw map[string]json.RawMessage
 	if err := json.Unmarshal(body, &raw); err != nil {
-		return nil, err
+		return nil, avDecodeError(params, err)
 	}
 
 	var result TimeSeriesIntradayResponse
 	if err := json.Unmarshal(raw["Meta Data"], &result.MetaData); err != nil {
-		return nil, err
+		return nil, avDecodeError(params, err)
 	}
 
 	timeSeriesKey := fmt.Sprintf("Time Series (%s)", interval)
 	if tsData, ok := raw[timeSeriesKey]; ok {
 		result.TimeSeries = make(map[string]IntradayDataPoint)
 		if err := json.Unmarshal(tsData, &result.TimeSeries); err != nil {
-			return nil, err
+			return nil, avDecodeError(params, err)
 		}
 	}
 
 	return &result, nil
 }
 
+// GetTimeSeriesDailyAdjusted returns daily OHLCV data with adjusted close,
+// dividends and split coefficients (premium endpoint)
+func (c *Client) GetTimeSeriesDailyAdjusted(symbol string, outputSize OutputSize) (*TimeSeriesAdjustedResponse, error) {
+	return c.GetTimeSeriesDailyAdjustedCtx(context.Background(), symbol, outputSize)
+}
+
+// GetTimeSeriesDailyAdjustedCtx is like GetTimeSeriesDailyAdjusted but aborts when ctx is done
+func (c *Client) GetTimeSeriesDailyAdjustedCtx(ctx context.Context, symbol string, outputSize OutputSize) (*TimeSeriesAdjustedResponse, error) {
+	params := map[string]string{
+		"function": "TIME_SERIES_DAILY_ADJUSTED",
+		"symbol":   symbol,
+	}
+	if outputSize != "" {
+		params["outputsize"] = string(outputSize)
+	}
+	return c.getAdjustedSeries(ctx, params, "Time Series (Daily)")
+}
+
+// GetTimeSeriesWeekly returns weekly OHLCV data for a symbol. Each point is
+// keyed by the last trading day of its week
+func (c *Client) GetTimeSeriesWeekly(symbol string) (*TimeSeriesDailyResponse, error) {
+	return c.GetTimeSeriesWeeklyCtx(context.Background(), symbol)
+}
+
+// GetTimeSeriesWeeklyCtx is like GetTimeSeriesWeekly but aborts when ctx is done
+func (c *Client) GetTimeSeriesWeeklyCtx(ctx context.Context, symbol string) (*TimeSeriesDailyResponse, error) {
+	params := map[string]string{
+		"function": "TIME_SERIES_WEEKLY",
+		"symbol":   symbol,
+	}
+	return c.getSeries(ctx, params, "Weekly Time Series")
+}
+
+// GetTimeSeriesWeeklyAdjusted returns weekly adjusted OHLCV data with dividends
+func (c *Client) GetTimeSeriesWeeklyAdjusted(symbol string) (*TimeSeriesAdjustedResponse, error) {
+	return c.GetTimeSeriesWeeklyAdjustedCtx(context.Background(), symbol)
+}
+
+// GetTimeSeriesWeeklyAdjustedCtx is like GetTimeSeriesWeeklyAdjusted but aborts when ctx is done
+func (c *Client) GetTimeSeriesWeeklyAdjustedCtx(ctx context.Context, symbol string) (*TimeSeriesAdjustedResponse, error) {
+	params := map[string]string{
+		"function": "TIME_SERIES_WEEKLY_ADJUSTED",
+		"symbol":   symbol,
+	}
+	return c.getAdjustedSeries(ctx, params, "Weekly Adjusted Time Series")
+}
+
+// GetTimeSeriesMonthly returns monthly OHLCV data for a symbol. Each point is
+// keyed by the last trading day of its month
+func (c *Client) GetTimeSeriesMonthly(symbol string) (*TimeSeriesDailyResponse, error) {
+	return c.GetTimeSeriesMonthlyCtx(context.Background(), symbol)
+}
+
+// GetTimeSeriesMonthlyCtx is like GetTimeSeriesMonthly but aborts when ctx is done
+func (c *Client) GetTimeSeriesMonthlyCtx(ctx context.Context, symbol string) (*TimeSeriesDailyResponse, error) {
+	params := map[string]string{
+		"function": "TIME_SERIES_MONTHLY",
+		"symbol":   symbol,
+	}
+	return c.getSeries(ctx, params, "Monthly Time Series")
+}
+
+// GetTimeSeriesMonthlyAdjusted returns monthly adjusted OHLCV data with dividends
+func (c *Client) GetTimeSeriesMonthlyAdjusted(symbol string) (*TimeSeriesAdjustedResponse, error) {
+	return c.GetTimeSeriesMonthlyAdjustedCtx(context.Background(), symbol)
+}
+
+// GetTimeSeriesMonthlyAdjustedCtx is like GetTimeSeriesMonthlyAdjusted but aborts when ctx is done
+func (c *Client) GetTimeSeriesMonthlyAdjustedCtx(ctx context.Context, symbol string) (*TimeSeriesAdjustedResponse, error) {
+	params := map[string]string{
+		"function": "TIME_SERIES_MONTHLY_ADJUSTED",
+		"symbol":   symbol,
+	}
+	return c.getAdjustedSeries(ctx, params, "Monthly Adjusted Time Series")
+}
+
+// getSeries fetches a series whose points have the same shape as daily ones
+func (c *Client) getSeries(ctx context.Context, params map[string]string, seriesKey string) (*TimeSeriesDailyResponse, error) {
+	meta, series, err := c.getRawSeries(ctx, params, seriesKey)
+	if err != nil {
+		return nil, err
+	}
+
+	result := TimeSeriesDailyResponse{MetaData: meta, TimeSeries: make(map[string]DailyDataPoint)}
+	if err := json.Unmarshal(series, &result.TimeSeries); err != nil {
+		return nil, avDecodeError(params, err)
+	}
+	return &result, nil
+}
+
+func (c *Client) getAdjustedSeries(ctx context.Context, params map[string]string, seriesKey string) (*TimeSeriesAdjustedResponse, error) {
+	meta, series, err := c.getRawSeries(ctx, params, seriesKey)
+	if err != nil {
+		return nil, err
+	}
+
+	result := TimeSeriesAdjustedResponse{MetaData: meta, TimeSeries: make(map[string]AdjustedDataPoint)}
+	if err := json.Unmarshal(series, &result.TimeSeries); err != nil {
+		return nil, avDecodeError(params, err)
+	}
+	return &result, nil
+}
+
+func (c *Client) getRawSeries(ctx context.Context, params map[string]string, seriesKey string) (TimeSeriesMetaData, json.RawMessage, error) {
+	body, err := c.doRequest(ctx, params)
+	if err != nil {
+		return TimeSeriesMetaData{}, nil, err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return TimeSeriesMetaData{}, nil, avDecodeError(params, err)
+	}
+
+	meta, err := parseTimeSeriesMetaData(raw["Meta Data"])
+	if err != nil {
+		return TimeSeriesMetaData{}, nil, avDecodeError(params, err)
+	}
+	if raw[seriesKey] == nil {
+		return TimeSeriesMetaData{}, nil, avMissingSeries(params, seriesKey)
+	}
+	return meta, raw[seriesKey], nil
+}
+
+// parseTimeSeriesMetaData reads "Meta Data" by field name, since the
+// numbering differs between functions ("4. Output Size" for daily,
+// "4. Time Zone" for weekly and monthly)
+func parseTimeSeriesMetaData(raw json.RawMessage) (TimeSeriesMetaData, error) {
+	var meta TimeSeriesMetaData
+	if raw == nil {
+		return meta, nil
+	}
+
+	var fields map[string]string
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return meta, err
+	}
+	for key, value := range fields {
+		if i := strings.Index(key, ". "); i >= 0 {
+			key = key[i+2:]
+		}
+		switch key {
+		case "Information":
+			meta.Information = value
+		case "Symbol":
+			meta.Symbol = value
+		case "Last Refreshed":
+			meta.LastRefreshed = value
+		case "Output Size":
+			meta.OutputSize = value
+		case "Time Zone":
+			meta.TimeZone = value
+		}
+	}
+	return meta, nil
+}
+
+// ToDaily converts an adjusted series so the daily filters, summaries and
+// chart functions accept it. With adjusted set, open, high, low and close
+// are scaled by adjusted close / close, which removes the jumps caused by
+// splits and dividends
+func (r *TimeSeriesAdjustedResponse) ToDaily(adjusted bool) *TimeSeriesDailyResponse {
+	if r == nil {
+		return nil
+	}
+
+	daily := &TimeSeriesDailyResponse{
+		MetaData:   r.MetaData,
+		TimeSeries: make(map[string]DailyDataPoint, len(r.TimeSeries)),
+	}
+	for date, p := range r.TimeSeries {
+		point := DailyDataPoint{Open: p.Open, High: p.High, Low: p.Low, Close: p.Close, Volume: p.Volume}
+		if adjusted {
+			close, _ := parseFloat(p.Close)
+			adjClose, err := parseFloat(p.AdjustedClose)
+			if err == nil && close != 0 {
+				point.Open = scalePrice(p.Open, adjClose/close)
+				point.High = scalePrice(p.High, adjClose/close)
+				point.Low = scalePrice(p.Low, adjClose/close)
+				point.Close = p.AdjustedClose
+			}
+		}
+		daily.TimeSeries[date] = point
+	}
+	return daily
+}
+
+func scalePrice(price string, factor float64) string {
+	v, err := parseFloat(price)
+	if err != nil {
+		return price
+	}
+	return strconv.FormatFloat(v*factor, 'f', 4, 64)
+}
+
 // FilterIntradayByDate filters intraday data for a specific date (YYYY-MM-DD)
 func FilterIntradayByDate(data *TimeSeriesIntradayResponse, date string) *TimeSeriesIntradayResponse {
 	if data == nil {
@@ -110,8 +318,13 @@ func FilterIntradayByDate(data *TimeSeriesIntradayResponse, date string) *TimeSe
 // Note: Alpha Vantage free tier only returns recent data (last 1-2 trading days)
 // Premium subscription required for extended intraday history
 func (c *Client) GetSingleDayData(symbol string, date string, interval Interval) (*TimeSeriesIntradayResponse, error) {
+	return c.GetSingleDayDataCtx(context.Background(), symbol, date, interval)
+}
+
+// GetSingleDayDataCtx is like GetSingleDayData but aborts when ctx is done
+func (c *Client) GetSingleDayDataCtx(ctx context.Context, symbol string, date string, interval Interval) (*TimeSeriesIntradayResponse, error) {
 	// Fetch intraday data
-	data, err := c.GetTimeSeriesIntraday(symbol, interval, OutputSizeFull)
+	data, err := c.GetTimeSeriesIntradayCtx(ctx, symbol, interval, OutputSizeFull)
 	if err != nil {
 		return nil, err
 	}
@@ -128,7 +341,12 @@ func (c *Client) GetSingleDayData(symbol string, date string, interval Interval)
 
 // GetDailyDataForDate returns a single day's OHLCV from daily time series
 func (c *Client) GetDailyDataForDate(symbol string, date string) (*DailyDataPoint, error) {
-	data, err := c.GetTimeSeriesDaily(symbol, OutputSizeCompact)
+	return c.GetDailyDataForDateCtx(context.Background(), symbol, date)
+}
+
+// GetDailyDataForDateCtx is like GetDailyDataForDate but aborts when ctx is done
+func (c *Client) GetDailyDataForDateCtx(ctx context.Context, symbol string, date string) (*DailyDataPoint, error) {
+	data, err := c.GetTimeSeriesDailyCtx(ctx, symbol, OutputSizeCompact)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,151 @@
+package alphavintage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// GetEarningsCallTranscript returns the earnings call transcript of a symbol
+// for a fiscal quarter, e.g. "2024Q1"
+func (c *Client) GetEarningsCallTranscript(symbol, quarter string) (*EarningsCallTranscriptResponse, error) {
+	return c.GetEarningsCallTranscriptCtx(context.Background(), symbol, quarter)
+}
+
+// GetEarningsCallTranscriptCtx is like GetEarningsCallTranscript but aborts when ctx is done
+func (c *Client) GetEarningsCallTranscriptCtx(ctx context.Context, symbol, quarter string) (*EarningsCallTranscriptResponse, error) {
+	params := map[string]string{
+		"function": "EARNINGS_CALL_TRANSCRIPT",
+		"symbol":   symbol,
+		"quarter":  quarter,
+	}
+
+	body, err := c.doRequest(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+
+	var result EarningsCallTranscriptResponse
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, avDecodeError(params, err)
+	}
+
+	// Quarters without a call, or not yet published, come back empty
+	if len(result.Transcript) == 0 {
+		return nil, &APIError{
+			Kind:     ErrAPI,
+			Provider: ProviderAlphaVantage,
+			Function: params["function"],
+			Symbol:   symbol,
+			Message:  "no transcript for " + quarter,
+		}
+	}
+
+	return &result, nil
+}
+
+// Score returns the sentiment as a number, 0 when missing
+func (s TranscriptSegment) Score() float64 {
+	score, _ := parseFloat(s.Sentiment)
+	return score
+}
+
+// IsManagement reports whether the speaker is with the company, as opposed
+// to the operator or an analyst
+func (s TranscriptSegment) IsManagement() bool {
+	title := strings.ToLower(s.Title)
+	if strings.EqualFold(s.Speaker, "Operator") || title == "operator" {
+		return false
+	}
+	return !strings.Contains(title, "analyst")
+}
+
+// TranscriptSpeaker aggregates the segments of one speaker
+type TranscriptSpeaker struct {
+	Name       string
+	Title      string
+	Management bool
+	Segments   int
+	Words      int
+	Sentiment  float64 // Average segment score
+}
+
+// Speakers returns each speaker once, in order of first appearance
+func (r *EarningsCallTranscriptResponse) Speakers() []TranscriptSpeaker {
+	var speakers []TranscriptSpeaker
+	index := make(map[string]int)
+	for _, s := range r.Transcript {
+		i, ok := index[s.Speaker]
+		if !ok {
+			i = len(speakers)
+			index[s.Speaker] = i
+			speakers = append(speakers, TranscriptSpeaker{
+				Name:       s.Speaker,
+				Title:      s.Title,
+				Management: s.IsManagement(),
+			})
+		}
+		sp := &speakers[i]
+		sp.Sentiment = (sp.Sentiment*float64(sp.Segments) + s.Score()) / float64(sp.Segments+1)
+		sp.Segments++
+		sp.Words += len(strings.Fields(s.Content))
+	}
+	return speakers
+}
+
+// Tone returns the average sentiment of management and of everyone else
+// (analysts and the operator), weighted by words spoken
+func (r *EarningsCallTranscriptResponse) Tone() (management, others float64) {
+	var mgmtWords, otherWords int
+	for _, s := range r.Transcript {
+		words := len(strings.Fields(s.Content))
+		if s.IsManagement() {
+			management += s.Score() * float64(words)
+			mgmtWords += words
+		} else {
+			others += s.Score() * float64(words)
+			otherWords += words
+		}
+	}
+	if mgmtWords > 0 {
+		management /= float64(mgmtWords)
+	}
+	if otherWords > 0 {
+		others /= float64(otherWords)
+	}
+	return management, others
+}
+
+// FindQuarterlyEarning returns the quarter of earnings matching a fiscal
+// quarter like "2024Q1", or nil. fiscalYearEnd is the month name from
+// CompanyOverview.FiscalYearEnd; empty means December
+func FindQuarterlyEarning(earnings *EarningsResponse, quarter, fiscalYearEnd string) *QuarterlyEarning {
+	if earnings == nil {
+		return nil
+	}
+	var year, q int
+	if _, err := fmt.Sscanf(quarter, "%dQ%d", &year, &q); err != nil || q < 1 || q > 4 {
+		return nil
+	}
+	month := time.December
+	if fiscalYearEnd != "" {
+		t, err := time.Parse("January", fiscalYearEnd)
+		if err != nil {
+			return nil
+		}
+		month = t.Month()
+	}
+
+	// Fiscal year Y ends in month M of calendar year Y, so quarter n ends
+	// 3 * (4 - n) months earlier
+	end := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).AddDate(0, -3*(4-q), 0)
+	prefix := end.Format("2006-01")
+	for i := range earnings.QuarterlyEarnings {
+		if strings.HasPrefix(earnings.QuarterlyEarnings[i].FiscalDateEnding, prefix) {
+			return &earnings.QuarterlyEarnings[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package alphavintage
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFindQuarterlyEarning(t *testing.T) {
+	earnings := &EarningsResponse{QuarterlyEarnings: []QuarterlyEarning{
+		{FiscalDateEnding: "2023-12-31"},
+		{FiscalDateEnding: "2023-09-30"},
+		{FiscalDateEnding: "2023-06-30"},
+		{FiscalDateEnding: "2023-03-31"},
+		{FiscalDateEnding: "2022-12-31"},
+	}}
+
+	tests := []struct {
+		quarter, fiscalYearEnd string
+		want                   string // FiscalDateEnding, empty = nil
+	}{
+		{"2023Q4", "", "2023-12-31"},
+		{"2023Q1", "December", "2023-03-31"},
+		// Fiscal year 2024 ends September 2024, so Q1 is the last calendar quarter of 2023
+		{"2024Q1", "September", "2023-12-31"},
+		{"2023Q4", "September", "2023-09-30"},
+		// Fiscal year 2024 ends June 2024
+		{"2024Q2", "June", "2023-12-31"},
+		{"2023Q4", "June", "2023-06-30"},
+		{"2023Q3", "June", "2023-03-31"},
+		{"2021Q1", "", ""},
+		{"2023Q5", "", ""},
+		{"Q1", "", ""},
+		{"2023Q1", "Smarch", ""},
+	}
+	for _, tt := range tests {
+		got := FindQuarterlyEarning(earnings, tt.quarter, tt.fiscalYearEnd)
+		switch {
+		case tt.want == "" && got != nil:
+			t.Errorf("FindQuarterlyEarning(%q, %q) = %s; want nil", tt.quarter, tt.fiscalYearEnd, got.FiscalDateEnding)
+		case tt.want != "" && (got == nil || got.FiscalDateEnding != tt.want):
+			t.Errorf("FindQuarterlyEarning(%q, %q) = %v; want %s", tt.quarter, tt.fiscalYearEnd, got, tt.want)
+		}
+	}
+	if FindQuarterlyEarning(nil, "2023Q4", "") != nil {
+		t.Error("FindQuarterlyEarning(nil) returned a quarter")
+	}
+}
+
+func TestTranscriptToneAndSpeakers(t *testing.T) {
+	transcript := &EarningsCallTranscriptResponse{Transcript: []TranscriptSegment{
+		{Speaker: "Operator", Title: "Operator", Content: "Welcome to the call", Sentiment: "0.1"},
+		{Speaker: "Jane Doe", Title: "CEO", Content: "We had a great quarter with record revenue", Sentiment: "0.8"},
+		{Speaker: "Sam Roe", Title: "Analyst, Big Bank", Content: "Why did margins fall", Sentiment: "-0.2"},
+		{Speaker: "Jane Doe", Title: "CEO", Content: "Costs", Sentiment: "0.0"},
+	}}
+
+	// Management: (8 * 0.8 + 1 * 0) / 9; others: (4 * 0.1 + 4 * -0.2) / 8
+	management, others := transcript.Tone()
+	if math.Abs(management-6.4/9) > 1e-9 || math.Abs(others-(-0.05)) > 1e-9 {
+		t.Fatalf("Tone = %v, %v; want %v, -0.05", management, others, 6.4/9)
+	}
+
+	speakers := transcript.Speakers()
+	if len(speakers) != 3 {
+		t.Fatalf("got %d speakers; want 3", len(speakers))
+	}
+	want := []TranscriptSpeaker{
+		{Name: "Operator", Title: "Operator", Segments: 1, Words: 4, Sentiment: 0.1},
+		{Name: "Jane Doe", Title: "CEO", Management: true, Segments: 2, Words: 9, Sentiment: 0.4},
+		{Name: "Sam Roe", Title: "Analyst, Big Bank", Segments: 1, Words: 4, Sentiment: -0.2},
+	}
+	for i, w := range want {
+		got := speakers[i]
+		if got.Name != w.Name || got.Title != w.Title || got.Management != w.Management ||
+			got.Segments != w.Segments || got.Words != w.Words || math.Abs(got.Sentiment-w.Sentiment) > 1e-9 {
+			t.Errorf("Speakers[%d] = %+v; want %+v", i, got, w)
+		}
+	}
+
+	// No segments on one side gives 0 rather than NaN
+	management, others = (&EarningsCallTranscriptResponse{}).Tone()
+	if management != 0 || others != 0 {
+		t.Fatalf("empty Tone = %v, %v; want 0, 0", management, others)
+	}
+}
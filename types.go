@@ -17,6 +17,35 @@ type Market struct {
 	Notes            string `json:"notes"`
 }
 
+// TopMoversResponse represents top gainers, losers and most actively
+// traded US tickers API response
+type TopMoversResponse struct {
+	Metadata           string  `json:"metadata"`
+	LastUpdated        string  `json:"last_updated"`
+	TopGainers         []Mover `json:"top_gainers"`
+	TopLosers          []Mover `json:"top_losers"`
+	MostActivelyTraded []Mover `json:"most_actively_traded"`
+}
+
+// Mover represents one ticker in a movers list. ChangePercentage looks
+// like "12.3456%"
+type Mover struct {
+	Ticker           string `json:"ticker"`
+	Price            string `json:"price"`
+	ChangeAmount     string `json:"change_amount"`
+	ChangePercentage string `json:"change_percentage"`
+	Volume           string `json:"volume"`
+}
+
+// TopMovers is TopMoversResponse with each list converted to quotes, biggest
+// mover first. LastUpdated is US/Eastern, e.g. "2024-12-20 16:15:59 US/Eastern"
+type TopMovers struct {
+	LastUpdated string
+	Gainers     []Quote
+	Losers      []Quote
+	MostActive  []Quote
+}
+
 // TimeSeriesDailyResponse represents daily time series data
 type TimeSeriesDailyResponse struct {
 	MetaData   TimeSeriesMetaData        `json:"Meta Data"`
@@ -41,6 +70,81 @@ type DailyDataPoint struct {
 	Volume string `json:"5. volume"`
 }
 
+// TimeSeriesAdjustedResponse represents daily, weekly or monthly adjusted time series data
+type TimeSeriesAdjustedResponse struct {
+	MetaData   TimeSeriesMetaData
+	TimeSeries map[string]AdjustedDataPoint
+}
+
+// AdjustedDataPoint represents OHLCV data with split/dividend adjustments
+type AdjustedDataPoint struct {
+	Open             string `json:"1. open"`
+	High             string `json:"2. high"`
+	Low              string `json:"3. low"`
+	Close            string `json:"4. close"`
+	AdjustedClose    string `json:"5. adjusted close"`
+	Volume           string `json:"6. volume"`
+	DividendAmount   string `json:"7. dividend amount"`
+	SplitCoefficient string `json:"8. split coefficient"` // Daily only
+}
+
+// Quote is the latest price of a symbol. ChangePercent is in percent, 1.5 = +1.5%
+type Quote struct {
+	Symbol           string
+	Open             float64
+	High             float64
+	Low              float64
+	Price            float64
+	Volume           int64
+	LatestTradingDay string // YYYY-MM-DD
+	PreviousClose    float64
+	Change           float64
+	ChangePercent    float64
+}
+
+// GlobalQuoteResponse represents the GLOBAL_QUOTE API response
+type GlobalQuoteResponse struct {
+	GlobalQuote GlobalQuote `json:"Global Quote"`
+}
+
+// GlobalQuote represents the raw quote fields of GLOBAL_QUOTE
+type GlobalQuote struct {
+	Symbol           string `json:"01. symbol"`
+	Open             string `json:"02. open"`
+	High             string `json:"03. high"`
+	Low              string `json:"04. low"`
+	Price            string `json:"05. price"`
+	Volume           string `json:"06. volume"`
+	LatestTradingDay string `json:"07. latest trading day"`
+	PreviousClose    string `json:"08. previous close"`
+	Change           string `json:"09. change"`
+	ChangePercent    string `json:"10. change percent"`
+}
+
+// BulkQuotesResponse represents the REALTIME_BULK_QUOTES API response
+type BulkQuotesResponse struct {
+	Endpoint string      `json:"endpoint"`
+	Message  string      `json:"message"`
+	Data     []BulkQuote `json:"data"`
+}
+
+// BulkQuote represents the raw quote fields of REALTIME_BULK_QUOTES
+type BulkQuote struct {
+	Symbol                     string `json:"symbol"`
+	Timestamp                  string `json:"timestamp"`
+	Open                       string `json:"open"`
+	High                       string `json:"high"`
+	Low                        string `json:"low"`
+	Close                      string `json:"close"`
+	Volume                     string `json:"volume"`
+	PreviousClose              string `json:"previous_close"`
+	Change                     string `json:"change"`
+	ChangePercent              string `json:"change_percent"`
+	ExtendedHoursQuote         string `json:"extended_hours_quote"`
+	ExtendedHoursChange        string `json:"extended_hours_change"`
+	ExtendedHoursChangePercent string `json:"extended_hours_change_percent"`
+}
+
 // TimeSeriesIntradayResponse represents intraday time series data
 type TimeSeriesIntradayResponse struct {
 	MetaData   IntradayMetaData             `json:"Meta Data"`
@@ -106,6 +210,67 @@ type TickerSentiment struct {
 	TickerSentimentLabel string `json:"ticker_sentiment_label"`
 }
 
+// CompanyOverview represents the OVERVIEW API response: company profile,
+// valuation ratios and analyst ratings. Ratios such as DividendYield and
+// ProfitMargin are fractions, 0.025 = 2.5%
+type CompanyOverview struct {
+	Symbol                     string `json:"Symbol"`
+	AssetType                  string `json:"AssetType"`
+	Name                       string `json:"Name"`
+	Description                string `json:"Description"`
+	CIK                        string `json:"CIK"`
+	Exchange                   string `json:"Exchange"`
+	Currency                   string `json:"Currency"`
+	Country                    string `json:"Country"`
+	Sector                     string `json:"Sector"`
+	Industry                   string `json:"Industry"`
+	Address                    string `json:"Address"`
+	OfficialSite               string `json:"OfficialSite"`
+	FiscalYearEnd              string `json:"FiscalYearEnd"`
+	LatestQuarter              string `json:"LatestQuarter"`
+	MarketCapitalization       string `json:"MarketCapitalization"`
+	EBITDA                     string `json:"EBITDA"`
+	PERatio                    string `json:"PERatio"`
+	PEGRatio                   string `json:"PEGRatio"`
+	BookValue                  string `json:"BookValue"`
+	DividendPerShare           string `json:"DividendPerShare"`
+	DividendYield              string `json:"DividendYield"`
+	EPS                        string `json:"EPS"`
+	RevenuePerShareTTM         string `json:"RevenuePerShareTTM"`
+	ProfitMargin               string `json:"ProfitMargin"`
+	OperatingMarginTTM         string `json:"OperatingMarginTTM"`
+	ReturnOnAssetsTTM          string `json:"ReturnOnAssetsTTM"`
+	ReturnOnEquityTTM          string `json:"ReturnOnEquityTTM"`
+	RevenueTTM                 string `json:"RevenueTTM"`
+	GrossProfitTTM             string `json:"GrossProfitTTM"`
+	DilutedEPSTTM              string `json:"DilutedEPSTTM"`
+	QuarterlyEarningsGrowthYOY string `json:"QuarterlyEarningsGrowthYOY"`
+	QuarterlyRevenueGrowthYOY  string `json:"QuarterlyRevenueGrowthYOY"`
+	AnalystTargetPrice         string `json:"AnalystTargetPrice"`
+	AnalystRatingStrongBuy     string `json:"AnalystRatingStrongBuy"`
+	AnalystRatingBuy           string `json:"AnalystRatingBuy"`
+	AnalystRatingHold          string `json:"AnalystRatingHold"`
+	AnalystRatingSell          string `json:"AnalystRatingSell"`
+	AnalystRatingStrongSell    string `json:"AnalystRatingStrongSell"`
+	TrailingPE                 string `json:"TrailingPE"`
+	ForwardPE                  string `json:"ForwardPE"`
+	PriceToSalesRatioTTM       string `json:"PriceToSalesRatioTTM"`
+	PriceToBookRatio           string `json:"PriceToBookRatio"`
+	EVToRevenue                string `json:"EVToRevenue"`
+	EVToEBITDA                 string `json:"EVToEBITDA"`
+	Beta                       string `json:"Beta"`
+	Week52High                 string `json:"52WeekHigh"`
+	Week52Low                  string `json:"52WeekLow"`
+	MovingAverage50Day         string `json:"50DayMovingAverage"`
+	MovingAverage200Day        string `json:"200DayMovingAverage"`
+	SharesOutstanding          string `json:"SharesOutstanding"`
+	SharesFloat                string `json:"SharesFloat"`
+	PercentInsiders            string `json:"PercentInsiders"`
+	PercentInstitutions        string `json:"PercentInstitutions"`
+	DividendDate               string `json:"DividendDate"`
+	ExDividendDate             string `json:"ExDividendDate"`
+}
+
 // BalanceSheetResponse represents balance sheet API response
 type BalanceSheetResponse struct {
 	Symbol           string               `json:"symbol"`
@@ -156,6 +321,43 @@ type BalanceSheetReport struct {
 }
 
 
+// IncomeStatementResponse represents income statement API response
+type IncomeStatementResponse struct {
+	Symbol           string                  `json:"symbol"`
+	AnnualReports    []IncomeStatementReport `json:"annualReports"`
+	QuarterlyReports []IncomeStatementReport `json:"quarterlyReports"`
+}
+
+// IncomeStatementReport represents a single income statement report
+type IncomeStatementReport struct {
+	FiscalDateEnding                  string `json:"fiscalDateEnding"`
+	ReportedCurrency                  string `json:"reportedCurrency"`
+	GrossProfit                       string `json:"grossProfit"`
+	TotalRevenue                      string `json:"totalRevenue"`
+	CostOfRevenue                     string `json:"costOfRevenue"`
+	CostOfGoodsAndServicesSold        string `json:"costofGoodsAndServicesSold"`
+	OperatingIncome                   string `json:"operatingIncome"`
+	SellingGeneralAndAdministrative   string `json:"sellingGeneralAndAdministrative"`
+	ResearchAndDevelopment            string `json:"researchAndDevelopment"`
+	OperatingExpenses                 string `json:"operatingExpenses"`
+	InvestmentIncomeNet               string `json:"investmentIncomeNet"`
+	NetInterestIncome                 string `json:"netInterestIncome"`
+	InterestIncome                    string `json:"interestIncome"`
+	InterestExpense                   string `json:"interestExpense"`
+	NonInterestIncome                 string `json:"nonInterestIncome"`
+	OtherNonOperatingIncome           string `json:"otherNonOperatingIncome"`
+	Depreciation                      string `json:"depreciation"`
+	DepreciationAndAmortization       string `json:"depreciationAndAmortization"`
+	IncomeBeforeTax                   string `json:"incomeBeforeTax"`
+	IncomeTaxExpense                  string `json:"incomeTaxExpense"`
+	InterestAndDebtExpense            string `json:"interestAndDebtExpense"`
+	NetIncomeFromContinuingOperations string `json:"netIncomeFromContinuingOperations"`
+	ComprehensiveIncomeNetOfTax       string `json:"comprehensiveIncomeNetOfTax"`
+	EBIT                              string `json:"ebit"`
+	EBITDA                            string `json:"ebitda"`
+	NetIncome                         string `json:"netIncome"`
+}
+
 // CashFlowResponse represents cash flow API response
 type CashFlowResponse struct {
 	Symbol           string           `json:"symbol"`
@@ -218,3 +420,282 @@ type QuarterlyEarning struct {
 	Surprise           string `json:"surprise"`
 	SurprisePercentage string `json:"surprisePercentage"`
 }
+
+// SymbolSearchResponse represents symbol search API response
+type SymbolSearchResponse struct {
+	BestMatches []SymbolMatch `json:"bestMatches"`
+}
+
+// SymbolMatch represents one symbol search match
+type SymbolMatch struct {
+	Symbol      string `json:"1. symbol"`
+	Name        string `json:"2. name"`
+	Type        string `json:"3. type"`
+	Region      string `json:"4. region"`
+	MarketOpen  string `json:"5. marketOpen"`
+	MarketClose string `json:"6. marketClose"`
+	Timezone    string `json:"7. timezone"`
+	Currency    string `json:"8. currency"`
+	MatchScore  string `json:"9. matchScore"`
+}
+
+// Listing represents one row of the listing status CSV
+type Listing struct {
+	Symbol        string
+	Name          string
+	Exchange      string
+	AssetType     string
+	IPODate       string
+	DelistingDate string // "null" while the symbol is active
+	Status        string // Active or Delisted
+}
+
+// EarningsCalendarEntry represents one row of the earnings calendar CSV
+type EarningsCalendarEntry struct {
+	Symbol           string
+	Name             string
+	ReportDate       string
+	FiscalDateEnding string
+	Estimate         string // Consensus EPS, empty when there is none
+	Currency         string
+}
+
+// IPOCalendarEntry represents one row of the IPO calendar CSV
+type IPOCalendarEntry struct {
+	Symbol         string
+	Name           string
+	IPODate        string
+	PriceRangeLow  string
+	PriceRangeHigh string
+	Currency       string
+	Exchange       string
+}
+
+// CurrencyExchangeRateResponse represents currency exchange rate API response
+type CurrencyExchangeRateResponse struct {
+	Rate ExchangeRate `json:"Realtime Currency Exchange Rate"`
+}
+
+// ExchangeRate represents the realtime rate between two currencies
+type ExchangeRate struct {
+	FromCurrencyCode string `json:"1. From_Currency Code"`
+	FromCurrencyName string `json:"2. From_Currency Name"`
+	ToCurrencyCode   string `json:"3. To_Currency Code"`
+	ToCurrencyName   string `json:"4. To_Currency Name"`
+	ExchangeRate     string `json:"5. Exchange Rate"`
+	LastRefreshed    string `json:"6. Last Refreshed"`
+	TimeZone         string `json:"7. Time Zone"`
+	BidPrice         string `json:"8. Bid Price"`
+	AskPrice         string `json:"9. Ask Price"`
+}
+
+// FXSeriesResponse represents intraday, daily, weekly or monthly FX data
+type FXSeriesResponse struct {
+	MetaData   FXMetaData
+	TimeSeries map[string]FXDataPoint
+}
+
+// FXMetaData contains metadata for FX series
+type FXMetaData struct {
+	Information   string
+	FromSymbol    string
+	ToSymbol      string
+	LastRefreshed string
+	Interval      string // Intraday only
+	OutputSize    string // Intraday and daily only
+	TimeZone      string
+}
+
+// FXDataPoint represents OHLC data for one FX bar
+type FXDataPoint struct {
+	Open  string `json:"1. open"`
+	High  string `json:"2. high"`
+	Low   string `json:"3. low"`
+	Close string `json:"4. close"`
+}
+
+// DigitalCurrencyResponse represents daily, weekly or monthly digital currency data
+type DigitalCurrencyResponse struct {
+	MetaData   DigitalCurrencyMetaData
+	TimeSeries map[string]DailyDataPoint
+}
+
+// CryptoIntradayResponse represents intraday digital currency data
+type CryptoIntradayResponse struct {
+	MetaData   DigitalCurrencyMetaData
+	TimeSeries map[string]IntradayDataPoint
+}
+
+// DigitalCurrencyMetaData contains metadata for digital currency series
+type DigitalCurrencyMetaData struct {
+	Information   string
+	CurrencyCode  string // e.g. BTC
+	CurrencyName  string
+	MarketCode    string // e.g. USD
+	MarketName    string
+	LastRefreshed string
+	Interval      string // Intraday only
+	OutputSize    string // Intraday only
+	TimeZone      string
+}
+
+// EconomicSeries represents an economic indicator or commodity price series
+type EconomicSeries struct {
+	Name     string              `json:"name"`
+	Interval string              `json:"interval"`
+	Unit     string              `json:"unit"`
+	Data     []EconomicDataPoint `json:"data"`
+}
+
+// EconomicDataPoint represents one observation, newest first. Value is "."
+// when there was no observation for the date
+type EconomicDataPoint struct {
+	Date  string `json:"date"`
+	Value string `json:"value"`
+}
+
+// IndicatorSeries represents a technical indicator series. Each point has one
+// value per column, e.g. "Real Upper Band", "Real Middle Band" and
+// "Real Lower Band" for BBANDS
+type IndicatorSeries struct {
+	MetaData IndicatorMetaData
+	Columns  []string
+	Points   []IndicatorPoint // Oldest first
+}
+
+// IndicatorMetaData contains metadata for technical indicator series
+type IndicatorMetaData struct {
+	Symbol        string
+	Indicator     string // e.g. "Simple Moving Average (SMA)"
+	LastRefreshed string
+	Interval      string
+	TimePeriod    string
+	SeriesType    string
+	TimeZone      string
+}
+
+// IndicatorPoint represents the indicator values at one date or timestamp
+type IndicatorPoint struct {
+	Time   string // YYYY-MM-DD, or YYYY-MM-DD HH:MM for intraday intervals
+	Values map[string]float64
+}
+
+// OptionsResponse represents the REALTIME_OPTIONS and HISTORICAL_OPTIONS API response
+type OptionsResponse struct {
+	Endpoint string        `json:"endpoint"`
+	Message  string        `json:"message"`
+	Data     []OptionQuote `json:"data"`
+}
+
+// OptionQuote represents the raw fields of one option contract
+type OptionQuote struct {
+	ContractID        string `json:"contractID"`
+	Symbol            string `json:"symbol"`
+	Expiration        string `json:"expiration"`
+	Strike            string `json:"strike"`
+	Type              string `json:"type"`
+	Last              string `json:"last"`
+	Mark              string `json:"mark"`
+	Bid               string `json:"bid"`
+	BidSize           string `json:"bid_size"`
+	Ask               string `json:"ask"`
+	AskSize           string `json:"ask_size"`
+	Volume            string `json:"volume"`
+	OpenInterest      string `json:"open_interest"`
+	Date              string `json:"date"`
+	ImpliedVolatility string `json:"implied_volatility"`
+	Delta             string `json:"delta"`
+	Gamma             string `json:"gamma"`
+	Theta             string `json:"theta"`
+	Vega              string `json:"vega"`
+	Rho               string `json:"rho"`
+}
+
+// OptionContract is an option contract with its quote and greeks.
+// ImpliedVolatility is a fraction, 0.25 = 25%
+type OptionContract struct {
+	ContractID        string
+	Symbol            string
+	Expiration        string // YYYY-MM-DD
+	Strike            float64
+	Type              OptionType
+	Last              float64
+	Mark              float64
+	Bid               float64
+	BidSize           int64
+	Ask               float64
+	AskSize           int64
+	Volume            int64
+	OpenInterest      int64
+	Date              string // Trading day of the quote
+	ImpliedVolatility float64
+	Delta             float64
+	Gamma             float64
+	Theta             float64
+	Vega              float64
+	Rho               float64
+}
+
+// DividendsResponse represents dividends API response, newest first
+type DividendsResponse struct {
+	Symbol string          `json:"symbol"`
+	Data   []DividendEvent `json:"data"`
+}
+
+// DividendEvent represents one dividend. Dates are "None" when not announced
+type DividendEvent struct {
+	ExDividendDate  string `json:"ex_dividend_date"`
+	DeclarationDate string `json:"declaration_date"`
+	RecordDate      string `json:"record_date"`
+	PaymentDate     string `json:"payment_date"`
+	Amount          string `json:"amount"`
+}
+
+// SplitsResponse represents splits API response
+type SplitsResponse struct {
+	Symbol string       `json:"symbol"`
+	Data   []SplitEvent `json:"data"`
+}
+
+// SplitEvent represents one split. SplitFactor is new shares per old share,
+// "4.0000" for a 4-for-1 split and "0.1000" for a 1-for-10 reverse split
+type SplitEvent struct {
+	EffectiveDate string `json:"effective_date"`
+	SplitFactor   string `json:"split_factor"`
+}
+
+// InsiderTransactionsResponse represents insider transactions API response,
+// newest first
+type InsiderTransactionsResponse struct {
+	Data []InsiderTransaction `json:"data"`
+}
+
+// InsiderTransaction represents one insider transaction. AcquisitionOrDisposal
+// is "A" or "D"
+type InsiderTransaction struct {
+	TransactionDate       string `json:"transaction_date"`
+	Ticker                string `json:"ticker"`
+	Executive             string `json:"executive"`
+	ExecutiveTitle        string `json:"executive_title"`
+	SecurityType          string `json:"security_type"`
+	AcquisitionOrDisposal string `json:"acquisition_or_disposal"`
+	Shares                string `json:"shares"`
+	SharePrice            string `json:"share_price"`
+}
+
+// EarningsCallTranscriptResponse represents earnings call transcript API
+// response. Quarter is the fiscal quarter, e.g. "2024Q1"
+type EarningsCallTranscriptResponse struct {
+	Symbol     string              `json:"symbol"`
+	Quarter    string              `json:"quarter"`
+	Transcript []TranscriptSegment `json:"transcript"`
+}
+
+// TranscriptSegment represents one speaker turn. Sentiment is a score from
+// -1 (negative) to 1 (positive)
+type TranscriptSegment struct {
+	Speaker   string `json:"speaker"`
+	Title     string `json:"title"`
+	Content   string `json:"content"`
+	Sentiment string `json:"sentiment"`
+}